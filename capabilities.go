@@ -0,0 +1,256 @@
+package unifi
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ErrUnsupportedOnThisController is returned instead of requesting an endpoint
+// the controller is known not to serve, such as stat/event on Network 10.x.
+var ErrUnsupportedOnThisController = errors.New("not supported on this controller")
+
+// legacyEventsRemovedMajor is the first Network major version without stat/event and stat/ips/event.
+const legacyEventsRemovedMajor = 10
+
+// NetworkInfo holds Network application information from /v2/api/info.
+type NetworkInfo struct {
+	Version string `json:"version"`
+}
+
+// DescribedFeature is a controller feature flag from /v2/api/site/{site}/described-features.
+type DescribedFeature struct {
+	Description string   `json:"description,omitempty"`
+	Exists      FlexBool `json:"feature_exists"`
+	Name        string   `json:"name"`
+}
+
+// Capabilities describes what a controller supports for a site. It is built by
+// Capabilities from the v2 info, described-features and wlan-capabilities
+// endpoints; any of them may be missing on older controllers.
+type Capabilities struct {
+	Info         *NetworkInfo    // nil when /v2/api/info is not available
+	MajorVersion int             // 0 when unknown
+	Features     map[string]bool // feature flag name to feature_exists
+	WLAN         map[string]any  // raw wlan-capabilities, e.g. 6ghz_band_supported
+	SiteName     string
+	SourceName   string
+}
+
+// HasFeature reports whether the named feature flag, e.g. "UPS_ADOPTED", is set.
+func (c *Capabilities) HasFeature(name string) bool {
+	return c != nil && c.Features[name]
+}
+
+// WLANSupports reports whether the named wireless capability, e.g. "wpa3_supported", is true.
+func (c *Capabilities) WLANSupports(name string) bool {
+	if c == nil {
+		return false
+	}
+
+	v, ok := c.WLAN[name].(bool)
+
+	return ok && v
+}
+
+// LegacyEvents reports whether the controller still serves stat/event and
+// stat/ips/event. These were removed in Network 10.x. An unknown version is
+// assumed to support them.
+func (c *Capabilities) LegacyEvents() bool {
+	return c == nil || c.MajorVersion == 0 || c.MajorVersion < legacyEventsRemovedMajor
+}
+
+// capabilitiesRetry is how long a failed capability probe is remembered before
+// Capabilities asks the controller again.
+const capabilitiesRetry = 5 * time.Minute
+
+// capabilitiesEntry is a cached probe result. A zero retryAt never expires.
+type capabilitiesEntry struct {
+	caps    *Capabilities
+	err     error
+	retryAt time.Time
+}
+
+// Capabilities probes the controller's info, described-features and
+// wlan-capabilities endpoints for a site and returns what it supports. Endpoints
+// the controller does not serve (ErrEndpointNotFound) are skipped, and the version
+// falls back to ServerStatus.MajorVersion. Results are cached per site for the life
+// of the client; any other probe failure is returned again for a few minutes
+// before the controller is probed again.
+func (u *Unifi) Capabilities(site *Site) (*Capabilities, error) {
+	if site == nil || site.Name == "" {
+		return nil, ErrNoSiteProvided
+	}
+
+	u.capabilitiesMu.Lock()
+	entry, ok := u.capabilities[site.Name]
+	u.capabilitiesMu.Unlock()
+
+	if ok && (entry.retryAt.IsZero() || time.Now().Before(entry.retryAt)) {
+		return entry.caps, entry.err
+	}
+
+	u.DebugLog("Probing Controller capabilities, site %s", site.SiteName)
+
+	entry = &capabilitiesEntry{}
+
+	entry.caps, entry.err = u.probeCapabilities(site)
+	if entry.err != nil {
+		entry.retryAt = time.Now().Add(capabilitiesRetry)
+	}
+
+	u.capabilitiesMu.Lock()
+	defer u.capabilitiesMu.Unlock()
+
+	// Another caller may have probed the same site meanwhile; keep its successful result.
+	if cached, ok := u.capabilities[site.Name]; ok && cached.err == nil {
+		return cached.caps, nil
+	}
+
+	if u.capabilities == nil {
+		u.capabilities = make(map[string]*capabilitiesEntry)
+	}
+
+	u.capabilities[site.Name] = entry
+
+	return entry.caps, entry.err
+}
+
+// probeCapabilities requests every capability endpoint for a site.
+func (u *Unifi) probeCapabilities(site *Site) (*Capabilities, error) {
+	caps := &Capabilities{
+		MajorVersion: u.ServerStatus.MajorVersion(),
+		Features:     make(map[string]bool),
+		WLAN:         make(map[string]any),
+		SiteName:     site.SiteName,
+		SourceName:   u.URL,
+	}
+
+	var info NetworkInfo
+	if err := u.probeCapability(APIV2InfoPath, &info); err != nil {
+		return nil, fmt.Errorf("probing network info for site %s: %w", site.SiteName, err)
+	}
+
+	if info.Version != "" {
+		caps.Info = &info
+
+		if major := parseMajorVersion(info.Version); major > 0 {
+			caps.MajorVersion = major
+		}
+	}
+
+	var features []DescribedFeature
+	if err := u.probeCapability(fmt.Sprintf(APIDescribedFeaturesPath, site.Name), &features); err != nil {
+		return nil, fmt.Errorf("probing described features for site %s: %w", site.SiteName, err)
+	}
+
+	for _, f := range features {
+		caps.Features[f.Name] = f.Exists.Val
+	}
+
+	if err := u.probeCapability(fmt.Sprintf(APIWLANCapabilitiesPath, site.Name), &caps.WLAN); err != nil {
+		return nil, fmt.Errorf("probing WLAN capabilities for site %s: %w", site.SiteName, err)
+	}
+
+	return caps, nil
+}
+
+// probeCapability fetches one capability endpoint into v. A missing endpoint is
+// not an error: it leaves v untouched.
+func (u *Unifi) probeCapability(apiPath string, v any) error {
+	err := u.GetData(apiPath, v)
+	if errors.Is(err, ErrEndpointNotFound) {
+		u.DebugLog("Capability endpoint unavailable: %v", err)
+
+		return nil
+	}
+
+	return err
+}
+
+// FeatureExists reports whether a controller feature flag, e.g. "UPS_ADOPTED",
+// is set for a site. Flags already known from Capabilities are not requested again,
+// and successful lookups are cached per site.
+// Uses the v2 API endpoint: GET /proxy/network/v2/api/site/{site}/features/{feature}/exists
+func (u *Unifi) FeatureExists(site *Site, feature string) (bool, error) {
+	caps, err := u.Capabilities(site)
+	if err != nil {
+		return false, err
+	}
+
+	if exists, ok := caps.Features[feature]; ok {
+		return exists, nil
+	}
+
+	u.capabilitiesMu.Lock()
+	exists, ok := u.featureFlags[site.Name][feature]
+	u.capabilitiesMu.Unlock()
+
+	if ok {
+		return exists, nil
+	}
+
+	u.DebugLog("Polling Controller for feature %s, site %s", feature, site.SiteName)
+
+	body, err := u.GetJSON(fmt.Sprintf(APIFeatureExistsPath, site.Name, feature))
+	if err != nil {
+		return false, fmt.Errorf("failed to fetch feature %s for site %s: %w", feature, site.SiteName, err)
+	}
+
+	var response struct {
+		Exists FlexBool `json:"feature_exists"`
+	}
+
+	if err := json.Unmarshal(body, &response); err != nil {
+		return false, fmt.Errorf("failed to parse feature %s for site %s: %w", feature, site.SiteName, err)
+	}
+
+	u.capabilitiesMu.Lock()
+	defer u.capabilitiesMu.Unlock()
+
+	if u.featureFlags == nil {
+		u.featureFlags = make(map[string]map[string]bool)
+	}
+
+	if u.featureFlags[site.Name] == nil {
+		u.featureFlags[site.Name] = make(map[string]bool)
+	}
+
+	u.featureFlags[site.Name][feature] = response.Exists.Val
+
+	return response.Exists.Val, nil
+}
+
+// requireLegacyEvents returns ErrUnsupportedOnThisController when the site's
+// controller no longer serves the legacy event endpoints. When capabilities
+// cannot be probed the decision falls back to ServerStatus.MajorVersion, and an
+// unknown version is assumed to serve them.
+func (u *Unifi) requireLegacyEvents(site *Site, endpoint string) error {
+	caps, err := u.Capabilities(site)
+	if errors.Is(err, ErrNoSiteProvided) {
+		return err
+	}
+
+	if err != nil {
+		u.DebugLog("Using server version to check %s: %v", endpoint, err)
+
+		caps = &Capabilities{MajorVersion: u.ServerStatus.MajorVersion()}
+	}
+
+	if !caps.LegacyEvents() {
+		return fmt.Errorf("%s on Network %d.x: %w", endpoint, caps.MajorVersion, ErrUnsupportedOnThisController)
+	}
+
+	return nil
+}
+
+// parseMajorVersion returns the major number of a version like "10.3.58", or 0.
+func parseMajorVersion(version string) int {
+	major, _, _ := strings.Cut(strings.TrimLeft(version, "vV"), ".")
+	n, _ := strconv.Atoi(major)
+
+	return n
+}
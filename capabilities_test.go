@@ -0,0 +1,210 @@
+package unifi // nolint: testpackage
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCapabilities(t *testing.T) {
+	t.Parallel()
+
+	var (
+		mu    sync.Mutex
+		calls = make(map[string]int)
+	)
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		calls[r.URL.Path]++
+		mu.Unlock()
+
+		switch r.URL.Path {
+		case "/proxy/network/v2/api/info":
+			_, _ = w.Write([]byte(`{"version":"10.3.58"}`))
+		case "/proxy/network/v2/api/site/default/described-features":
+			_, _ = w.Write([]byte(`[{"name":"UPS_ADOPTED","feature_exists":true},{"name":"AFC_CAPABLE_AP_ADOPTED","feature_exists":false}]`))
+		case "/proxy/network/v2/api/site/default/wlan-capabilities":
+			_, _ = w.Write([]byte(`{"6ghz_band_supported":true,"wpa3_supported":false}`))
+		case "/proxy/network/v2/api/site/default/features/ZONE_BASED_FIREWALL/exists":
+			_, _ = w.Write([]byte(`{"feature_exists":true}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer srv.Close()
+
+	u := &Unifi{
+		Client:       &http.Client{},
+		Config:       &Config{URL: srv.URL, DebugLog: discardLogs, ErrorLog: discardLogs},
+		ServerStatus: &ServerStatus{ServerVersion: "9.0.114"},
+	}
+	site := &Site{Name: "default"}
+
+	caps, err := u.Capabilities(site)
+	require.NoError(t, err)
+	assert.Equal(t, 10, caps.MajorVersion, "info version overrides ServerStatus")
+	assert.True(t, caps.HasFeature("UPS_ADOPTED"))
+	assert.False(t, caps.HasFeature("AFC_CAPABLE_AP_ADOPTED"))
+	assert.True(t, caps.WLANSupports("6ghz_band_supported"))
+	assert.False(t, caps.WLANSupports("wpa3_supported"))
+	assert.False(t, caps.LegacyEvents())
+
+	exists, err := u.FeatureExists(site, "ZONE_BASED_FIREWALL")
+	require.NoError(t, err)
+	assert.True(t, exists)
+
+	exists, err = u.FeatureExists(site, "UPS_ADOPTED")
+	require.NoError(t, err)
+	assert.True(t, exists)
+
+	_, err = u.GetSiteEvents(site, time.Hour)
+	require.ErrorIs(t, err, ErrUnsupportedOnThisController)
+
+	_, err = u.GetIDSSite(site)
+	require.ErrorIs(t, err, ErrUnsupportedOnThisController)
+
+	mu.Lock()
+	defer mu.Unlock()
+
+	assert.Equal(t, 1, calls["/proxy/network/v2/api/info"], "capabilities should be cached")
+	assert.Equal(t, 1, calls["/proxy/network/v2/api/site/default/features/ZONE_BASED_FIREWALL/exists"])
+	assert.Zero(t, calls["/proxy/network/v2/api/site/default/features/UPS_ADOPTED/exists"])
+	assert.Zero(t, calls["/api/s/default/stat/event"])
+	assert.Zero(t, calls["/api/s/default/stat/ips/event"])
+}
+
+func TestCapabilitiesLegacyController(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/s/default/stat/event" {
+			_, _ = w.Write([]byte(`{"data":[],"meta":{"rc":"ok"}}`))
+
+			return
+		}
+
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer srv.Close()
+
+	u := &Unifi{
+		Client:       &http.Client{},
+		Config:       &Config{URL: srv.URL, DebugLog: discardLogs, ErrorLog: discardLogs},
+		ServerStatus: &ServerStatus{ServerVersion: "8.6.9"},
+	}
+	site := &Site{Name: "default"}
+
+	caps, err := u.Capabilities(site)
+	require.NoError(t, err)
+	assert.Nil(t, caps.Info)
+	assert.Equal(t, 8, caps.MajorVersion)
+	assert.True(t, caps.LegacyEvents())
+
+	_, err = u.GetSiteEvents(site, time.Hour)
+	require.NoError(t, err)
+}
+
+func TestCapabilitiesTransientError(t *testing.T) {
+	t.Parallel()
+
+	var (
+		mu    sync.Mutex
+		fail  = true
+		calls int
+	)
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/proxy/network/v2/api/info":
+			mu.Lock()
+			defer mu.Unlock()
+
+			calls++
+
+			if fail {
+				w.WriteHeader(http.StatusBadGateway)
+
+				return
+			}
+
+			_, _ = w.Write([]byte(`{"version":"10.0.160"}`))
+		case "/api/s/default/stat/event":
+			_, _ = w.Write([]byte(`{"data":[],"meta":{"rc":"ok"}}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer srv.Close()
+
+	u := &Unifi{
+		Client:       &http.Client{},
+		Config:       &Config{URL: srv.URL, DebugLog: discardLogs, ErrorLog: discardLogs},
+		ServerStatus: &ServerStatus{ServerVersion: "9.0.114"},
+	}
+	site := &Site{Name: "default"}
+
+	_, err := u.Capabilities(site)
+	require.ErrorIs(t, err, ErrInvalidStatusCode)
+
+	_, err = u.Capabilities(site)
+	require.ErrorIs(t, err, ErrInvalidStatusCode, "a failed probe is remembered")
+
+	_, err = u.GetSiteEvents(site, time.Hour)
+	require.NoError(t, err, "ServerStatus 9.x still serves stat/event")
+
+	mu.Lock()
+	assert.Equal(t, 1, calls, "a failed probe must not be repeated before it expires")
+	fail = false
+	mu.Unlock()
+
+	u.capabilitiesMu.Lock()
+	u.capabilities[site.Name].retryAt = time.Now().Add(-time.Second)
+	u.capabilitiesMu.Unlock()
+
+	caps, err := u.Capabilities(site)
+	require.NoError(t, err, "an expired failure is probed again")
+	assert.Equal(t, 10, caps.MajorVersion)
+
+	_, err = u.Capabilities(site)
+	require.NoError(t, err)
+
+	mu.Lock()
+	assert.Equal(t, 2, calls, "a successful probe should be cached")
+	mu.Unlock()
+
+	_, err = u.GetSiteEvents(site, time.Hour)
+	require.ErrorIs(t, err, ErrUnsupportedOnThisController)
+}
+
+func TestRequireLegacyEventsServerStatusFallback(t *testing.T) {
+	t.Parallel()
+
+	var calls atomic.Int32
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		calls.Add(1)
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer srv.Close()
+
+	u := &Unifi{
+		Client:       &http.Client{},
+		Config:       &Config{URL: srv.URL, DebugLog: discardLogs, ErrorLog: discardLogs},
+		ServerStatus: &ServerStatus{ServerVersion: "10.1.68"},
+	}
+	site := &Site{Name: "default"}
+
+	for range 3 {
+		_, err := u.GetSiteEvents(site, time.Hour)
+		require.ErrorIs(t, err, ErrUnsupportedOnThisController)
+	}
+
+	assert.Equal(t, int32(1), calls.Load(), "only the first poll should probe the controller")
+}
@@ -0,0 +1,310 @@
+package unifi
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// ErrNoChannelPlan is returned when the controller reports no channel plan for a site.
+var ErrNoChannelPlan = errors.New("controller returned no channel plan")
+
+// Severities of a RadioIssue.
+const (
+	RadioIssueError   = "error"   // the setting is not allowed for the country
+	RadioIssueWarning = "warning" // the setting is allowed but not recommended
+)
+
+// Radio bands as reported in RadioTable.Radio.
+const (
+	RadioBand2G = "ng"
+	RadioBand5G = "na"
+	RadioBand6G = "6e"
+)
+
+// baseChannelWidth is the channel width in MHz of the base channels_{band} lists.
+const baseChannelWidth = 20
+
+// Non-overlapping 2.4 GHz channel sets. FCC domains stop at channel 11, while
+// ETSI and Japan allow channel 13 and so fit four 20 MHz channels.
+var (
+	nonOverlapping2GFCC  = []int{1, 6, 11}    // nolint: gochecknoglobals
+	nonOverlapping2GETSI = []int{1, 5, 9, 13} // nolint: gochecknoglobals
+)
+
+// CountryCode is a regulatory domain from /api/s/{site}/stat/ccode.
+// Code is the numeric ISO 3166 code, e.g. 840, and Key the alpha-2 code, e.g. US.
+type CountryCode struct {
+	Code FlexInt `json:"code"`
+	Key  string  `fake:"{countryabr}" json:"key"`
+	Name string  `fake:"{country}"    json:"name"`
+
+	SiteName   string `json:"-"`
+	SourceName string `json:"-"`
+}
+
+// ChannelPlan is the set of channels allowed for a site's country code, from
+// /api/s/{site}/stat/current-channel. Channels holds every channels_* list the
+// controller returns without the prefix, keyed by band and optional width or
+// flag, e.g. "ng", "na_80", "na_dfs" or "6e_160".
+type ChannelPlan struct {
+	Code     FlexInt          `json:"code"`
+	Key      string           `fake:"{countryabr}" json:"key"`
+	Name     string           `fake:"{country}"    json:"name"`
+	Channels map[string][]int `fake:"-"            json:"-"`
+
+	SiteName   string `json:"-"`
+	SourceName string `json:"-"`
+}
+
+// UnmarshalJSON decodes the fixed fields and collects the channels_* lists into Channels.
+func (p *ChannelPlan) UnmarshalJSON(b []byte) error {
+	type plan ChannelPlan
+
+	if err := json.Unmarshal(b, (*plan)(p)); err != nil {
+		return fmt.Errorf("json unmarshal: %w", err)
+	}
+
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(b, &raw); err != nil {
+		return fmt.Errorf("json unmarshal: %w", err)
+	}
+
+	p.Channels = make(map[string][]int)
+
+	for k, v := range raw {
+		key, ok := strings.CutPrefix(k, "channels_")
+		if !ok {
+			continue
+		}
+
+		var list []FlexInt
+		if err := json.Unmarshal(v, &list); err != nil {
+			continue // not a channel list
+		}
+
+		channels := make([]int, len(list))
+		for i := range list {
+			channels[i] = list[i].Int()
+		}
+
+		p.Channels[key] = channels
+	}
+
+	return nil
+}
+
+// Allowed returns the channels allowed on a band at a width in MHz, including DFS
+// channels. Widths of 20 MHz or less return the band's base list. It returns nil
+// when the plan has no list for the band and width.
+func (p *ChannelPlan) Allowed(band string, width int) []int {
+	if p == nil {
+		return nil
+	}
+
+	if width > baseChannelWidth {
+		return p.Channels[band+"_"+strconv.Itoa(width)]
+	}
+
+	base := p.Channels[band]
+	dfs := p.Channels[band+"_dfs"]
+
+	if len(dfs) == 0 {
+		return base
+	}
+
+	allowed := slices.Clone(base)
+
+	for _, ch := range dfs {
+		if !slices.Contains(allowed, ch) {
+			allowed = append(allowed, ch)
+		}
+	}
+
+	return allowed
+}
+
+// NonOverlapping2G returns the 2.4 GHz channels that do not overlap each other in
+// the plan's regulatory domain: 1, 5, 9 and 13 where channel 13 is allowed, such
+// as ETSI countries and Japan, otherwise 1, 6 and 11.
+func (p *ChannelPlan) NonOverlapping2G() []int {
+	if slices.Contains(p.Allowed(RadioBand2G, 0), 13) {
+		return nonOverlapping2GETSI
+	}
+
+	return nonOverlapping2GFCC
+}
+
+// IsDFS reports whether a channel on a band requires dynamic frequency selection.
+func (p *ChannelPlan) IsDFS(band string, channel int) bool {
+	return p != nil && slices.Contains(p.Channels[band+"_dfs"], channel)
+}
+
+// RadioIssue is a radio setting that ValidateUAPRadios found illegal or suboptimal.
+// Field is channel, width or tx_power and Value the configured setting.
+type RadioIssue struct {
+	DeviceMac   string `fake:"{macaddress}"                            json:"device_mac"`
+	DeviceName  string `json:"device_name"`
+	Radio       string `fake:"{randomstring:[ng,na,6e]}"               json:"radio"`
+	Field       string `fake:"{randomstring:[channel,width,tx_power]}" json:"field"`
+	Value       int    `json:"value"`
+	Severity    string `fake:"{randomstring:[error,warning]}"          json:"severity"`
+	Description string `json:"description"`
+}
+
+// GetCountryCodes returns the regulatory domains the controller knows about.
+// Uses the legacy API endpoint: GET /api/s/{site}/stat/ccode.
+func (u *Unifi) GetCountryCodes(site *Site) ([]*CountryCode, error) {
+	if site == nil || site.Name == "" {
+		return nil, ErrNoSiteProvided
+	}
+
+	u.DebugLog("Polling Controller for country codes, site %s", site.SiteName)
+
+	path := fmt.Sprintf(APICountryCodesPath, site.Name)
+
+	var response struct {
+		Data []CountryCode `json:"data"`
+	}
+
+	if err := u.GetData(path, &response); err != nil {
+		return nil, fmt.Errorf("fetching country codes for site %s: %w", site.SiteName, err)
+	}
+
+	result := make([]*CountryCode, len(response.Data))
+
+	for i := range response.Data {
+		response.Data[i].SiteName = site.SiteName
+		response.Data[i].SourceName = u.URL
+		result[i] = &response.Data[i]
+	}
+
+	return result, nil
+}
+
+// GetChannelPlan returns the channels allowed for the site's country code.
+// Uses the legacy API endpoint: GET /api/s/{site}/stat/current-channel.
+func (u *Unifi) GetChannelPlan(site *Site) (*ChannelPlan, error) {
+	if site == nil || site.Name == "" {
+		return nil, ErrNoSiteProvided
+	}
+
+	u.DebugLog("Polling Controller for channel plan, site %s", site.SiteName)
+
+	path := fmt.Sprintf(APICurrentChannelPath, site.Name)
+
+	var response struct {
+		Data []ChannelPlan `json:"data"`
+	}
+
+	if err := u.GetData(path, &response); err != nil {
+		return nil, fmt.Errorf("fetching channel plan for site %s: %w", site.SiteName, err)
+	}
+
+	if len(response.Data) == 0 {
+		return nil, fmt.Errorf("site %s: %w", site.SiteName, ErrNoChannelPlan)
+	}
+
+	plan := &response.Data[0]
+	plan.SiteName = site.SiteName
+	plan.SourceName = u.URL
+
+	return plan, nil
+}
+
+// CheckUAPRadios fetches the site's channel plan and access points and validates
+// every radio with ValidateUAPRadios.
+func (u *Unifi) CheckUAPRadios(site *Site) ([]*RadioIssue, error) {
+	plan, err := u.GetChannelPlan(site)
+	if err != nil {
+		return nil, err
+	}
+
+	uaps, err := u.GetUAPs(site)
+	if err != nil {
+		return nil, err
+	}
+
+	return ValidateUAPRadios(uaps, plan), nil
+}
+
+// ValidateUAPRadios checks each access point radio against a channel plan:
+// the channel must be allowed for the country at the configured width, DFS
+// channels need a DFS capable radio, and a custom tx power must be within the
+// radio hardware's min_txpower-max_txpower range. The plan carries no regulatory
+// power limits, so tx power is not checked against the country. 2.4 GHz radios
+// get a warning at 40 MHz or on a channel outside the plan's NonOverlapping2G set.
+// Channel 0 (auto), bands the plan does not list and widths without a
+// channels_{band}_{width} list are not checked.
+func ValidateUAPRadios(uaps []*UAP, plan *ChannelPlan) []*RadioIssue {
+	issues := make([]*RadioIssue, 0)
+
+	for _, uap := range uaps {
+		if uap == nil {
+			continue
+		}
+
+		for _, radio := range uap.RadioTable {
+			add := func(field string, value int, severity, format string, args ...any) {
+				issues = append(issues, &RadioIssue{
+					DeviceMac:   uap.Mac,
+					DeviceName:  uap.Name,
+					Radio:       radio.Radio,
+					Field:       field,
+					Value:       value,
+					Severity:    severity,
+					Description: fmt.Sprintf(format, args...),
+				})
+			}
+
+			channel, width := radio.Channel.Int(), radio.Ht.Int()
+
+			if allowed := plan.Allowed(radio.Radio, 0); channel != 0 && len(allowed) > 0 {
+				wide := plan.Allowed(radio.Radio, width)
+
+				switch {
+				case !slices.Contains(allowed, channel):
+					add("channel", channel, RadioIssueError, "channel %d is not allowed on %s in %s", channel, radio.Radio, plan.Key)
+				case width > baseChannelWidth && len(wide) > 0 && !slices.Contains(wide, channel):
+					add("width", width, RadioIssueError, "channel %d cannot be used at %d MHz in %s", channel, width, plan.Key)
+				case plan.IsDFS(radio.Radio, channel) && !radio.HasDfs.Val:
+					add("channel", channel, RadioIssueError, "channel %d requires DFS, which the radio does not support", channel)
+				}
+
+				if spaced := plan.NonOverlapping2G(); radio.Radio == RadioBand2G && !slices.Contains(spaced, channel) {
+					add("channel", channel, RadioIssueWarning, "2.4 GHz channel %d overlaps channels %s in %s",
+						channel, joinChannels(spaced), plan.Key)
+				}
+			}
+
+			if radio.Radio == RadioBand2G && width > baseChannelWidth {
+				add("width", width, RadioIssueWarning, "%d MHz on 2.4 GHz overlaps neighbouring networks", width)
+			}
+
+			if tx := radio.TxPower.Int(); radio.TxPowerMode == "custom" && radio.MaxTxpower.Val > 0 &&
+				(tx > radio.MaxTxpower.Int() || tx < radio.MinTxpower.Int()) {
+				add("tx_power", tx, RadioIssueError, "tx power %d dBm is outside the radio's %d-%d dBm range",
+					tx, radio.MinTxpower.Int(), radio.MaxTxpower.Int())
+			}
+		}
+	}
+
+	return issues
+}
+
+// joinChannels formats channels as "1, 6 and 11".
+func joinChannels(channels []int) string {
+	list := make([]string, len(channels))
+	for i, ch := range channels {
+		list[i] = strconv.Itoa(ch)
+	}
+
+	if len(list) < 2 {
+		return strings.Join(list, "")
+	}
+
+	return strings.Join(list[:len(list)-1], ", ") + " and " + list[len(list)-1]
+}
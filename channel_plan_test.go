@@ -0,0 +1,83 @@
+package unifi // nolint: testpackage
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateUAPRadios(t *testing.T) {
+	t.Parallel()
+
+	var plan ChannelPlan
+
+	require.NoError(t, json.Unmarshal([]byte(`{
+		"code": "840", "key": "US", "name": "United States",
+		"channels_ng": [1,2,3,4,5,6,7,8,9,10,11],
+		"channels_ng_40": [3,4,5,6,7,8,9],
+		"channels_na": [36,40,44,48,149,153,157,161,165],
+		"channels_na_dfs": [52,56,60,64,100,104],
+		"channels_na_80": [36,40,44,48,52,56,60,64,149,153,157,161],
+		"unrelated": "value"
+	}`), &plan))
+	assert.Equal(t, "US", plan.Key)
+	assert.Equal(t, 840, plan.Code.Int())
+	assert.Contains(t, plan.Allowed(RadioBand5G, 20), 100)
+	assert.True(t, plan.IsDFS(RadioBand5G, 52))
+
+	var uap UAP
+
+	require.NoError(t, json.Unmarshal([]byte(`{"mac":"aa:bb:cc:dd:ee:ff","name":"office","radio_table":[
+		{"radio":"ng","channel":3,"ht":40},
+		{"radio":"na","channel":100,"ht":80,"has_dfs":true,"tx_power_mode":"custom","tx_power":30,"min_txpower":6,"max_txpower":26},
+		{"radio":"na","channel":52,"ht":20,"has_dfs":false},
+		{"radio":"na","channel":0,"ht":80},
+		{"radio":"na","channel":36,"ht":160},
+		{"radio":"6e","channel":37,"ht":160}
+	]}`), &uap))
+
+	require.Empty(t, plan.Allowed(RadioBand5G, 160), "no channels_na_160 list: the width is not checked")
+
+	issues := ValidateUAPRadios([]*UAP{&uap, nil}, &plan)
+
+	type issue struct{ radio, field, severity string }
+
+	got := make([]issue, len(issues))
+	for i, is := range issues {
+		got[i] = issue{is.Radio, is.Field, is.Severity}
+		assert.Equal(t, "office", is.DeviceName)
+	}
+
+	assert.Equal(t, []issue{
+		{RadioBand2G, "channel", RadioIssueWarning},
+		{RadioBand2G, "width", RadioIssueWarning},
+		{RadioBand5G, "width", RadioIssueError},
+		{RadioBand5G, "tx_power", RadioIssueError},
+		{RadioBand5G, "channel", RadioIssueError},
+	}, got)
+	assert.Equal(t, "2.4 GHz channel 3 overlaps channels 1, 6 and 11 in US", issues[0].Description)
+	assert.Equal(t, "tx power 30 dBm is outside the radio's 6-26 dBm range", issues[3].Description)
+}
+
+func TestValidateUAPRadiosETSI(t *testing.T) {
+	t.Parallel()
+
+	plan := &ChannelPlan{Key: "DE", Channels: map[string][]int{
+		RadioBand2G: {1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13},
+	}}
+	assert.Equal(t, []int{1, 5, 9, 13}, plan.NonOverlapping2G())
+
+	var uap UAP
+
+	require.NoError(t, json.Unmarshal([]byte(`{"name":"hall","radio_table":[
+		{"radio":"ng","channel":13,"ht":20},
+		{"radio":"ng","channel":6,"ht":20}
+	]}`), &uap))
+
+	issues := ValidateUAPRadios([]*UAP{&uap}, plan)
+	require.Len(t, issues, 1, "channel 13 is clear in ETSI domains")
+	assert.Equal(t, 6, issues[0].Value)
+	assert.Equal(t, "2.4 GHz channel 6 overlaps channels 1, 5, 9 and 13 in DE", issues[0].Description)
+}
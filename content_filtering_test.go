@@ -0,0 +1,30 @@
+package unifi_test
+
+import (
+	"testing"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/stretchr/testify/require"
+	"github.com/unpoller/unifi/v5"
+)
+
+func TestContentFilteringProfileStruct(t *testing.T) {
+	t.Parallel()
+
+	var p unifi.ContentFilteringProfile
+
+	err := gofakeit.Struct(&p)
+	require.NoError(t, err)
+	require.NotEmpty(t, p.ID)
+	require.NotEmpty(t, p.Categories)
+}
+
+func TestContentFilteringCategoryStruct(t *testing.T) {
+	t.Parallel()
+
+	var c unifi.ContentFilteringCategory
+
+	err := gofakeit.Struct(&c)
+	require.NoError(t, err)
+	require.NotEmpty(t, c.ID)
+}
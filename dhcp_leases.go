@@ -4,6 +4,8 @@ import (
 	"encoding/json"
 	"fmt"
 	"net"
+	"net/netip"
+	"slices"
 	"strings"
 )
 
@@ -522,6 +524,7 @@ type DHCPLease struct {
 	AssociatedDevice  interface{}        `json:"-"` // Associated device if found (UAP, USW, USG, UDM, UXG, PDU, UBB, or UCI)
 	AssociatedNetwork *Network           `json:"-"` // Associated network if found
 	NetworkTableEntry *NetworkTableEntry `json:"-"` // Network table entry from device (contains DHCP pool range)
+	ExcludedIPs       []*ExcludedIPRange `json:"-"` // Ranges excluded from the pool (populated by ApplyExcludedIPs)
 }
 
 // NetworkTableEntry represents a network entry from a device's NetworkTable.
@@ -565,10 +568,72 @@ func (l *DHCPLease) GetActiveLeaseCount() int {
 	return int(l.NetworkTableEntry.ActiveDhcpLeaseCount.Val)
 }
 
+// GetExcludedIPCount returns the number of pool addresses excluded from DHCP.
+// Only ranges attached with ApplyExcludedIPs are counted, and only where they overlap the pool.
+// Addresses covered by more than one range are counted once.
+func (l *DHCPLease) GetExcludedIPCount() int {
+	if len(l.ExcludedIPs) == 0 || l.GetPoolSize() == 0 {
+		return 0
+	}
+
+	start, err := netip.ParseAddr(l.NetworkTableEntry.DhcpdStart)
+	if err != nil {
+		return 0
+	}
+
+	stop, err := netip.ParseAddr(l.NetworkTableEntry.DhcpdStop)
+	if err != nil {
+		return 0
+	}
+
+	type span struct{ from, to netip.Addr }
+
+	spans := make([]span, 0, len(l.ExcludedIPs))
+
+	for _, e := range l.ExcludedIPs {
+		if e == nil {
+			continue
+		}
+
+		if from, to, ok := e.clip(start, stop); ok {
+			spans = append(spans, span{from, to})
+		}
+	}
+
+	// Merge overlapping ranges so shared addresses are only counted once.
+	slices.SortFunc(spans, func(a, b span) int { return a.from.Compare(b.from) })
+
+	count := 0
+
+	for i := 0; i < len(spans); {
+		cur := spans[i]
+
+		for i++; i < len(spans) && spans[i].from.Compare(cur.to.Next()) <= 0; i++ {
+			if spans[i].to.Compare(cur.to) > 0 {
+				cur.to = spans[i].to
+			}
+		}
+
+		count += calculateIPRangeSize(cur.from.String(), cur.to.String())
+	}
+
+	return count
+}
+
+// GetUsablePoolSize returns the DHCP pool size minus excluded addresses.
+func (l *DHCPLease) GetUsablePoolSize() int {
+	usable := l.GetPoolSize() - l.GetExcludedIPCount()
+	if usable < 0 {
+		return 0
+	}
+
+	return usable
+}
+
 // GetUtilizationPercentage calculates the DHCP pool utilization percentage.
-// Returns 0 if pool size cannot be determined.
+// Excluded addresses are left out of the pool. Returns 0 if pool size cannot be determined.
 func (l *DHCPLease) GetUtilizationPercentage() float64 {
-	poolSize := l.GetPoolSize()
+	poolSize := l.GetUsablePoolSize()
 	if poolSize == 0 {
 		return 0
 	}
@@ -581,9 +646,10 @@ func (l *DHCPLease) GetUtilizationPercentage() float64 {
 	return (float64(activeCount) / float64(poolSize)) * 100.0
 }
 
-// GetAvailableIPs returns the number of available IPs in the DHCP pool.
+// GetAvailableIPs returns the number of available IPs in the DHCP pool,
+// not counting addresses excluded with ApplyExcludedIPs.
 func (l *DHCPLease) GetAvailableIPs() int {
-	poolSize := l.GetPoolSize()
+	poolSize := l.GetUsablePoolSize()
 	if poolSize == 0 {
 		return 0
 	}
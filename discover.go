@@ -43,9 +43,12 @@ func (u *Unifi) DiscoverEndpoints(site, outputPath string) error {
 		{"GET", fmt.Sprintf(APIDeviceTagsPath, site)},
 		{"GET", fmt.Sprintf(APIActiveDHCPLeasesPath, site)},
 		{"GET", fmt.Sprintf(APIWANEnrichedConfigPath, site)},
+		{"GET", fmt.Sprintf(APILANEnrichedConfigPath, site)},
 		{"GET", fmt.Sprintf(APIWANLoadBalancingStatusPath, site)},
 		{"GET", fmt.Sprintf(APIWANLoadBalancingConfigPath, site)},
 		{"GET", fmt.Sprintf(APIWANSLAsPath, site)},
+		{"GET", fmt.Sprintf(APIBGPConfigPath, site)},
+		{"GET", fmt.Sprintf(APIOSPFRouterPath, site)},
 		{"GET", fmt.Sprintf(APIClientTrafficPath, site, start, end, false)},
 		{"GET", fmt.Sprintf(APICountryTrafficPath, site, start, end)},
 		{"GET", fmt.Sprintf(APIAggregatedDashboard, site, 86400)},
@@ -81,10 +84,12 @@ func (u *Unifi) DiscoverEndpoints(site, outputPath string) error {
 		results = append(results, DiscoverResult{Method: "GET", Path: path, Status: status})
 	}
 
-	return writeDiscoverReport(u.URL, site, results, outputPath)
+	routing := u.GetDynamicRoutingStatus(&Site{Name: site, SiteName: site})
+
+	return writeDiscoverReport(u.URL, site, results, routing, outputPath)
 }
 
-func writeDiscoverReport(controllerURL, site string, results []DiscoverResult, outputPath string) error {
+func writeDiscoverReport(controllerURL, site string, results []DiscoverResult, routing *DynamicRoutingStatus, outputPath string) error {
 	sort.Slice(results, func(i, j int) bool {
 		return results[i].Path < results[j].Path
 	})
@@ -108,8 +113,26 @@ func writeDiscoverReport(controllerURL, site string, results []DiscoverResult, o
 		fmt.Fprintf(&b, "| %s | `%s` | %s |\n", r.Method, pathEscaped, statusStr)
 	}
 
+	b.WriteString("\n## Dynamic routing\n\n")
+	fmt.Fprintf(&b, "- **BGP**: %s\n", describeDynamicRouting(routing.BGPActive, routing.BGPConfigs, "configurations", routing.BGPErr))
+	fmt.Fprintf(&b, "- **OSPF**: %s\n", describeDynamicRouting(routing.OSPFActive, routing.OSPFRouters, "routers", routing.OSPFErr))
+
 	b.WriteString("\n---\n\n")
 	b.WriteString("Share this file with maintainers when reporting API or 404 issues.\n")
 
 	return os.WriteFile(outputPath, []byte(b.String()), 0o600)
 }
+
+// describeDynamicRouting formats one protocol line of the dynamic routing section.
+func describeDynamicRouting(active, total int, noun string, err error) string {
+	switch {
+	case err != nil:
+		return "unknown (" + err.Error() + ")"
+	case active > 0:
+		return fmt.Sprintf("active (%d of %d %s)", active, total, noun)
+	case total > 0:
+		return fmt.Sprintf("configured, not active (%d %s)", total, noun)
+	default:
+		return "not configured"
+	}
+}
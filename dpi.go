@@ -1,6 +1,9 @@
 package unifi
 
-import "strconv"
+import (
+	"strconv"
+	"sync"
+)
 
 // DPITable contains DPI data for clients or sites, or .. things.
 type DPITable struct {
@@ -34,11 +37,19 @@ type DPIClient struct {
 	TxPackets FlexInt `json:"tx_packets"`
 }
 
+// dpiMapMu guards DPICats and DPIApps, which ExtendDPIMaps may change at runtime.
+var dpiMapMu sync.RWMutex // nolint: gochecknoglobals
+
 // DPIMap allows binding methods to the DPICat and DPIApps variables.
+// Use Get, GetApp and Keys to read DPICats and DPIApps: indexing or ranging
+// over them directly races with ExtendDPIMaps and RefreshDPIMaps.
 type DPIMap map[int]string
 
 // Get returns a value, or an unknown placeholder.
 func (d DPIMap) Get(cat int) string {
+	dpiMapMu.RLock()
+	defer dpiMapMu.RUnlock()
+
 	if v, ok := d[cat]; ok {
 		return v
 	}
@@ -48,6 +59,9 @@ func (d DPIMap) Get(cat int) string {
 
 // GetApp returns an app value, or an unknown placeholder.
 func (d DPIMap) GetApp(cat, app int) string {
+	dpiMapMu.RLock()
+	defer dpiMapMu.RUnlock()
+
 	if v, ok := d[cat<<16+app]; ok {
 		return v
 	}
@@ -57,6 +71,9 @@ func (d DPIMap) GetApp(cat, app int) string {
 
 // Keys returns the map keys in a slice.
 func (d DPIMap) Keys() []string {
+	dpiMapMu.RLock()
+	defer dpiMapMu.RUnlock()
+
 	out := []string{}
 	for k := range d {
 		out = append(out, strconv.Itoa(k))
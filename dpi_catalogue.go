@@ -1,6 +1,9 @@
 package unifi
 
-import "fmt"
+import (
+	"fmt"
+	"strings"
+)
 
 // GetDPIApplications returns the DPI application reference catalogue (global, no site).
 // Requires Config.APIKey; returns ErrAPIKeyRequired when no key is configured.
@@ -35,3 +38,70 @@ func (u *Unifi) GetDPICategories() ([]*DPICategory, error) {
 
 	return result, nil
 }
+
+// RefreshDPIMaps fetches the DPI application and category catalogues from the
+// controller and adds them to DPIApps and DPICats with ExtendDPIMaps, so apps
+// classified after this library was released are named instead of "Unknown_".
+// It returns the number of names added. Requires Config.APIKey.
+func (u *Unifi) RefreshDPIMaps() (int, error) {
+	cats, err := u.GetDPICategories()
+	if err != nil {
+		return 0, err
+	}
+
+	apps, err := u.GetDPIApplications()
+	if err != nil {
+		return 0, err
+	}
+
+	added := ExtendDPIMaps(cats, apps)
+	u.DebugLog("Extended DPI maps with %d names from the controller catalogue", added)
+
+	return added, nil
+}
+
+// ExtendDPIMaps adds catalogue entries to the built-in DPICats and DPIApps maps.
+// Application IDs are the combined cat<<16+app keys used by DPIMap.GetApp.
+// Built-in names are kept unless they are an "Unknown_" placeholder. It returns
+// the number of names added or replaced, and is safe to call while the maps are read.
+func ExtendDPIMaps(cats []*DPICategory, apps []*DPIApplication) int {
+	dpiMapMu.Lock()
+	defer dpiMapMu.Unlock()
+
+	return extendDPIMaps(DPICats, DPIApps, cats, apps)
+}
+
+// extendDPIMaps adds cats to catMap and apps to appMap and returns the number of names set.
+// The caller must hold dpiMapMu when the maps are the package globals.
+func extendDPIMaps(catMap, appMap DPIMap, cats []*DPICategory, apps []*DPIApplication) int {
+	added := 0
+
+	for _, c := range cats {
+		if c != nil && extendDPIMap(catMap, c.ID.Int(), c.Name) {
+			added++
+		}
+	}
+
+	for _, a := range apps {
+		if a != nil && extendDPIMap(appMap, a.ID.Int(), a.Name) {
+			added++
+		}
+	}
+
+	return added
+}
+
+// extendDPIMap sets d[key] to name when the key is missing or only has a placeholder.
+func extendDPIMap(d DPIMap, key int, name string) bool {
+	if name == "" {
+		return false
+	}
+
+	if v, ok := d[key]; ok && (v == name || !strings.HasPrefix(v, "Unknown")) {
+		return false
+	}
+
+	d[key] = name
+
+	return true
+}
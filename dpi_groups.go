@@ -0,0 +1,134 @@
+package unifi
+
+import "fmt"
+
+// DPIApp is a user-defined DPI restriction from /api/s/{site}/rest/dpiapp. It
+// matches DPI applications (Apps, combined cat<<16+app keys) and whole categories
+// (Cats), and can block or rate limit them. QoS rates are in kbps; -1 is unlimited.
+type DPIApp struct {
+	ID             string    `fake:"{uuid}"     json:"_id"`
+	Apps           []FlexInt `fakesize:"3"      json:"apps"`
+	Blocked        FlexBool  `json:"blocked"`
+	Cats           []FlexInt `fakesize:"1"      json:"cats"`
+	Enabled        FlexBool  `json:"enabled"`
+	Log            FlexBool  `json:"log"`
+	Name           string    `fake:"{appname}"  json:"name,omitempty"`
+	QOSRateMaxDown FlexInt   `json:"qos_rate_max_down"`
+	QOSRateMaxUp   FlexInt   `json:"qos_rate_max_up"`
+	SiteID         string    `fake:"{uuid}"     json:"site_id"`
+
+	SiteName   string `json:"-"`
+	SourceName string `json:"-"`
+}
+
+// AppNames returns the names of the matched applications and categories using
+// DPIApps and DPICats. See ExtendDPIMaps to name apps newer than this library.
+func (a *DPIApp) AppNames() []string {
+	names := make([]string, 0, len(a.Apps)+len(a.Cats))
+
+	for _, cat := range a.Cats {
+		names = append(names, DPICats.Get(cat.Int()))
+	}
+
+	for _, app := range a.Apps {
+		names = append(names, DPIApps.GetApp(0, app.Int()))
+	}
+
+	return names
+}
+
+// DPIGroup is a user-defined group of DPIApp restrictions from /api/s/{site}/rest/dpigroup.
+// Groups are assigned to clients through their network or user group.
+type DPIGroup struct {
+	ID           string   `fake:"{uuid}"     json:"_id"`
+	AttrHiddenID string   `json:"attr_hidden_id,omitempty"`
+	AttrNoDelete FlexBool `json:"attr_no_delete"`
+	DPIAppIDs    []string `fakesize:"2"      json:"dpiapp_ids"`
+	Name         string   `fake:"{buzzword}" json:"name"`
+	SiteID       string   `fake:"{uuid}"     json:"site_id"`
+
+	SiteName   string `json:"-"`
+	SourceName string `json:"-"`
+}
+
+// Apps returns the restrictions in the group, looked up by ID in apps.
+// IDs missing from apps are skipped.
+func (g *DPIGroup) Apps(apps []*DPIApp) []*DPIApp {
+	byID := make(map[string]*DPIApp, len(apps))
+
+	for _, a := range apps {
+		if a != nil {
+			byID[a.ID] = a
+		}
+	}
+
+	result := make([]*DPIApp, 0, len(g.DPIAppIDs))
+
+	for _, id := range g.DPIAppIDs {
+		if a, ok := byID[id]; ok {
+			result = append(result, a)
+		}
+	}
+
+	return result
+}
+
+// GetDPIApps returns user-defined DPI restrictions for a single site.
+// Uses the legacy API endpoint: GET /api/s/{site}/rest/dpiapp.
+func (u *Unifi) GetDPIApps(site *Site) ([]*DPIApp, error) {
+	if site == nil || site.Name == "" {
+		return nil, ErrNoSiteProvided
+	}
+
+	u.DebugLog("Polling Controller for DPI apps, site %s", site.SiteName)
+
+	path := fmt.Sprintf(APIDPIAppPath, site.Name)
+
+	var response struct {
+		Data []DPIApp `json:"data"`
+	}
+
+	if err := u.GetData(path, &response); err != nil {
+		return nil, fmt.Errorf("fetching DPI apps for site %s: %w", site.SiteName, err)
+	}
+
+	result := make([]*DPIApp, len(response.Data))
+
+	for i := range response.Data {
+		response.Data[i].SiteName = site.SiteName
+		response.Data[i].SourceName = u.URL
+		result[i] = &response.Data[i]
+	}
+
+	return result, nil
+}
+
+// GetDPIGroups returns user-defined DPI groups for a single site.
+// Uses the legacy API endpoint: GET /api/s/{site}/rest/dpigroup.
+func (u *Unifi) GetDPIGroups(site *Site) ([]*DPIGroup, error) {
+	if site == nil || site.Name == "" {
+		return nil, ErrNoSiteProvided
+	}
+
+	u.DebugLog("Polling Controller for DPI groups, site %s", site.SiteName)
+
+	path := fmt.Sprintf(APIDPIGroupPath, site.Name)
+
+	var response struct {
+		Data []DPIGroup `json:"data"`
+	}
+
+	if err := u.GetData(path, &response); err != nil {
+		return nil, fmt.Errorf("fetching DPI groups for site %s: %w", site.SiteName, err)
+	}
+
+	result := make([]*DPIGroup, len(response.Data))
+
+	for i := range response.Data {
+		response.Data[i].SiteName = site.SiteName
+		response.Data[i].SourceName = u.URL
+		result[i] = &response.Data[i]
+	}
+
+	return result, nil
+}
@@ -0,0 +1,39 @@
+package unifi_test
+
+import (
+	"testing"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/unpoller/unifi/v5"
+)
+
+func TestDPIAppStruct(t *testing.T) {
+	t.Parallel()
+
+	var a unifi.DPIApp
+
+	require.NoError(t, gofakeit.Struct(&a))
+	require.NotEmpty(t, a.ID)
+	require.Len(t, a.Apps, 3)
+}
+
+func TestDPIGroupApps(t *testing.T) {
+	t.Parallel()
+
+	var g unifi.DPIGroup
+
+	require.NoError(t, gofakeit.Struct(&g))
+	require.Len(t, g.DPIAppIDs, 2)
+
+	apps := []*unifi.DPIApp{
+		{ID: g.DPIAppIDs[1], Apps: []unifi.FlexInt{*unifi.NewFlexInt(1573022)}, Cats: []unifi.FlexInt{*unifi.NewFlexInt(4)}},
+		nil,
+		{ID: "other"},
+	}
+
+	got := g.Apps(apps)
+	require.Len(t, got, 1)
+	assert.Equal(t, []string{"Media Streaming", "Instagram"}, got[0].AppNames())
+}
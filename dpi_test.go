@@ -0,0 +1,39 @@
+package unifi // nolint: testpackage
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestExtendDPIMaps(t *testing.T) {
+	t.Parallel()
+
+	const (
+		cat = 97
+		app = cat<<16 + 1
+	)
+
+	// Local maps keep the package-wide DPICats and DPIApps untouched.
+	cats := DPIMap{4: "Media Streaming", 5: "Unknown_5"}
+	apps := DPIMap{}
+
+	require.Equal(t, "Unknown_97", cats.Get(cat))
+
+	added := extendDPIMaps(cats, apps,
+		[]*DPICategory{
+			{ID: *NewFlexInt(cat), Name: "Test Category"},
+			{ID: *NewFlexInt(4), Name: "Renamed"},
+			{ID: *NewFlexInt(5), Name: "Placeholder Replaced"},
+		},
+		[]*DPIApplication{{ID: *NewFlexInt(app), Name: "Test App"}, nil},
+	)
+
+	require.Equal(t, 3, added)
+	require.Equal(t, "Test Category", cats.Get(cat))
+	require.Equal(t, "Placeholder Replaced", cats.Get(5))
+	require.Equal(t, "Test App", apps.GetApp(cat, 1))
+	require.Equal(t, "Media Streaming", cats.Get(4), "built-in names are kept")
+	require.Zero(t, extendDPIMaps(cats, apps, nil, []*DPIApplication{{ID: *NewFlexInt(app), Name: "Test App"}}))
+	require.Equal(t, "Unknown_97", DPICats.Get(cat), "globals must not change")
+}
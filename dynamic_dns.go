@@ -0,0 +1,285 @@
+package unifi
+
+import (
+	"fmt"
+	"net/netip"
+	"slices"
+	"strings"
+	"time"
+)
+
+// DynamicDNSStaleAge is the age after which a dynamic DNS update is flagged as
+// stale. Many providers expire hostnames that are not refreshed within 30 days.
+const DynamicDNSStaleAge = 30 * 24 * time.Hour
+
+// Dynamic DNS sync states reported in DynamicDNSCheck.Status.
+const (
+	DynamicDNSInSync    = "in_sync"
+	DynamicDNSOutOfSync = "out_of_sync"
+	DynamicDNSUnknown   = "unknown"
+)
+
+// DynamicDNS represents a dynamic DNS configuration from /api/s/{site}/rest/dynamicdns.
+// Interface is the WAN the hostname tracks, e.g. "wan" or "wan2".
+type DynamicDNS struct {
+	ID            string   `fake:"{uuid}"                              json:"_id"`
+	CustomService string   `json:"custom_service,omitempty"`
+	HostName      string   `fake:"{domainname}"                        json:"host_name"`
+	Interface     string   `fake:"{randomstring:[wan,wan2]}"           json:"interface"`
+	Login         string   `fake:"{username}"                          json:"login"`
+	Options       []string `json:"options,omitempty"`
+	Password      string   `json:"x_password,omitempty"`
+	Server        string   `fake:"{domainname}"                        json:"server,omitempty"`
+	Service       string   `fake:"{randomstring:[dyndns,noip,custom]}" json:"service"`
+	SiteID        string   `fake:"{uuid}"                              json:"site_id"`
+
+	SiteName   string `json:"-"`
+	SourceName string `json:"-"`
+}
+
+// DynamicDNSStatus is the last update the gateway pushed to a dynamic DNS provider,
+// from /api/s/{site}/stat/dynamicdns. LastChanged is a Unix timestamp.
+type DynamicDNSStatus struct {
+	HostName    string  `fake:"{domainname}"              json:"host_name"`
+	Interface   string  `fake:"{randomstring:[wan,wan2]}" json:"interface"`
+	IP          string  `fake:"{ipv4address}"             json:"ip"`
+	LastChanged FlexInt `json:"last_changed"`
+	Service     string  `json:"service"`
+	Status      string  `json:"status"` // provider response, e.g. "good" or "nochg"
+
+	SiteName   string `json:"-"`
+	SourceName string `json:"-"`
+}
+
+// DynamicDNSCheck compares the IP a dynamic DNS hostname was last updated to with
+// the current address of the WAN it tracks.
+type DynamicDNSCheck struct {
+	Config      *DynamicDNS
+	Update      *DynamicDNSStatus // nil when the gateway reports no update for the hostname
+	ReportedIP  string            // IP last pushed to the provider
+	WANIPs      []string          // current public addresses of Config.Interface
+	Status      string            // DynamicDNSInSync, DynamicDNSOutOfSync or DynamicDNSUnknown
+	UpdateAge   time.Duration     // time since the last update, 0 when unknown
+	Stale       bool              // the last update is older than DynamicDNSStaleAge
+	Description string
+}
+
+// OutOfSync reports whether the hostname is known to point at an old address.
+func (c *DynamicDNSCheck) OutOfSync() bool {
+	return c.Status == DynamicDNSOutOfSync
+}
+
+// GetDynamicDNS returns dynamic DNS configurations for a single site.
+// Uses the legacy API endpoint: GET /api/s/{site}/rest/dynamicdns.
+func (u *Unifi) GetDynamicDNS(site *Site) ([]*DynamicDNS, error) {
+	if site == nil || site.Name == "" {
+		return nil, ErrNoSiteProvided
+	}
+
+	u.DebugLog("Polling Controller for dynamic DNS, site %s", site.SiteName)
+
+	path := fmt.Sprintf(APIDynamicDNSPath, site.Name)
+
+	var response struct {
+		Data []DynamicDNS `json:"data"`
+	}
+
+	if err := u.GetData(path, &response); err != nil {
+		return nil, fmt.Errorf("fetching dynamic DNS for site %s: %w", site.SiteName, err)
+	}
+
+	result := make([]*DynamicDNS, len(response.Data))
+
+	for i := range response.Data {
+		response.Data[i].SiteName = site.SiteName
+		response.Data[i].SourceName = u.URL
+		result[i] = &response.Data[i]
+	}
+
+	return result, nil
+}
+
+// GetDynamicDNSStatus returns the last update pushed to each dynamic DNS provider for a single site.
+// Uses the legacy API endpoint: GET /api/s/{site}/stat/dynamicdns.
+func (u *Unifi) GetDynamicDNSStatus(site *Site) ([]*DynamicDNSStatus, error) {
+	if site == nil || site.Name == "" {
+		return nil, ErrNoSiteProvided
+	}
+
+	u.DebugLog("Polling Controller for dynamic DNS status, site %s", site.SiteName)
+
+	path := fmt.Sprintf(APIDynamicDNSStatusPath, site.Name)
+
+	var response struct {
+		Data []DynamicDNSStatus `json:"data"`
+	}
+
+	if err := u.GetData(path, &response); err != nil {
+		return nil, fmt.Errorf("fetching dynamic DNS status for site %s: %w", site.SiteName, err)
+	}
+
+	result := make([]*DynamicDNSStatus, len(response.Data))
+
+	for i := range response.Data {
+		response.Data[i].SiteName = site.SiteName
+		response.Data[i].SourceName = u.URL
+		result[i] = &response.Data[i]
+	}
+
+	return result, nil
+}
+
+// GetDynamicDNSHealth fetches the dynamic DNS configuration and status, devices, site
+// health and sysinfo for a site and reports whether each hostname is in sync. Sysinfo
+// is only available on UniFi OS and is skipped when it fails. See DynamicDNSWANIPs
+// and CheckDynamicDNS.
+func (u *Unifi) GetDynamicDNSHealth(site *Site) ([]*DynamicDNSCheck, error) {
+	configs, err := u.GetDynamicDNS(site)
+	if err != nil {
+		return nil, err
+	}
+
+	statuses, err := u.GetDynamicDNSStatus(site)
+	if err != nil {
+		return nil, err
+	}
+
+	devices, err := u.GetDevices([]*Site{site})
+	if err != nil {
+		return nil, err
+	}
+
+	health, err := u.GetSiteHealth(site)
+	if err != nil {
+		return nil, err
+	}
+
+	sysinfo, err := u.GetSysinfoSite(site)
+	if err != nil {
+		u.DebugLog("Sysinfo unavailable for dynamic DNS health, site %s: %v", site.SiteName, err)
+	}
+
+	return CheckDynamicDNS(configs, statuses, DynamicDNSWANIPs(devices, health, sysinfo)), nil
+}
+
+// DynamicDNSWANIPs returns the public addresses of each WAN keyed by the interface
+// names used in DynamicDNS.Interface: "wan" and "wan2". The gateways' wan1 and wan2
+// uplinks are used first. When no gateway reports an address for "wan", the site's
+// WAN health IP is used, and then the public addresses in sysinfo. Any argument may be nil.
+func DynamicDNSWANIPs(devices *Devices, health []*SubsystemHealth, sysinfo *Sysinfo) map[string][]string {
+	ips := make(map[string][]string)
+
+	add := func(iface, ip string) {
+		if addr, err := netip.ParseAddr(ip); err == nil && isPublicAddr(addr) && !slices.Contains(ips[iface], addr.String()) {
+			ips[iface] = append(ips[iface], addr.String())
+		}
+	}
+
+	addGateway := func(wan1, wan2 Wan) {
+		add("wan", wan1.IP)
+		add("wan2", wan2.IP)
+	}
+
+	if devices != nil {
+		for _, d := range devices.USGs {
+			addGateway(d.Wan1, d.Wan2)
+		}
+
+		for _, d := range devices.UDMs {
+			addGateway(d.Wan1, d.Wan2)
+		}
+
+		for _, d := range devices.UXGs {
+			addGateway(d.Wan1, d.Wan2)
+		}
+	}
+
+	if wan := FindSubsystemHealth(health, SubsystemWAN); wan != nil && len(ips["wan"]) == 0 {
+		add("wan", wan.WanIP)
+	}
+
+	if sysinfo != nil && len(ips["wan"]) == 0 {
+		for _, ip := range sysinfo.IPAddrs {
+			add("wan", ip)
+		}
+	}
+
+	return ips
+}
+
+// CheckDynamicDNS matches each configuration to its last update by interface and
+// hostname, and compares the updated IP with the public addresses of the WAN the
+// configuration tracks, from wanIPs (see DynamicDNSWANIPs). An empty interface means
+// "wan". The status is unknown when the gateway reports no update or the WAN has no
+// known public address. Updates older than DynamicDNSStaleAge are flagged Stale.
+func CheckDynamicDNS(configs []*DynamicDNS, statuses []*DynamicDNSStatus, wanIPs map[string][]string) []*DynamicDNSCheck {
+	checks := make([]*DynamicDNSCheck, 0, len(configs))
+
+	for _, cfg := range configs {
+		if cfg == nil {
+			continue
+		}
+
+		iface := strings.ToLower(pick(cfg.Interface, "wan"))
+		check := &DynamicDNSCheck{Config: cfg, WANIPs: wanIPs[iface], Status: DynamicDNSUnknown}
+		check.Update = findDynamicDNSStatus(cfg, statuses)
+
+		if check.Update != nil {
+			check.ReportedIP = check.Update.IP
+
+			if changed := check.Update.LastChanged.Int64(); changed > 0 {
+				check.UpdateAge = time.Since(time.Unix(changed, 0)).Truncate(time.Second)
+				check.Stale = check.UpdateAge > DynamicDNSStaleAge
+			}
+		}
+
+		switch {
+		case check.Update == nil || check.Update.IP == "":
+			check.Description = "gateway reports no update for " + cfg.HostName
+		case len(check.WANIPs) == 0:
+			check.Description = "current IP of " + iface + " is unknown"
+		case slices.Contains(check.WANIPs, check.Update.IP):
+			check.Status = DynamicDNSInSync
+			check.Description = cfg.HostName + " points at " + check.Update.IP
+		default:
+			check.Status = DynamicDNSOutOfSync
+			check.Description = fmt.Sprintf("%s was last updated to %s but the %s IP is %s",
+				cfg.HostName, check.Update.IP, iface, strings.Join(check.WANIPs, ", "))
+		}
+
+		if check.Stale {
+			check.Description += fmt.Sprintf("; last updated %s ago", check.UpdateAge)
+		}
+
+		checks = append(checks, check)
+	}
+
+	return checks
+}
+
+// findDynamicDNSStatus returns the update for cfg, preferring a match on both
+// interface and hostname. Hostnames are compared case-insensitively.
+func findDynamicDNSStatus(cfg *DynamicDNS, statuses []*DynamicDNSStatus) *DynamicDNSStatus {
+	var byHost *DynamicDNSStatus
+
+	for _, s := range statuses {
+		if s == nil || !strings.EqualFold(s.HostName, cfg.HostName) {
+			continue
+		}
+
+		if s.Interface == cfg.Interface {
+			return s
+		}
+
+		if byHost == nil {
+			byHost = s
+		}
+	}
+
+	return byHost
+}
+
+// isPublicAddr reports whether addr is a globally routable unicast address.
+func isPublicAddr(addr netip.Addr) bool {
+	return addr.IsGlobalUnicast() && !addr.IsPrivate()
+}
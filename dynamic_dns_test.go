@@ -0,0 +1,89 @@
+package unifi_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/stretchr/testify/require"
+	"github.com/unpoller/unifi/v5"
+)
+
+func TestDynamicDNSStruct(t *testing.T) {
+	t.Parallel()
+
+	var d unifi.DynamicDNS
+
+	require.NoError(t, gofakeit.Struct(&d))
+	require.NotEmpty(t, d.HostName)
+
+	var s unifi.DynamicDNSStatus
+
+	require.NoError(t, gofakeit.Struct(&s))
+	require.NotEmpty(t, s.IP)
+}
+
+func TestCheckDynamicDNS(t *testing.T) {
+	t.Parallel()
+
+	recent := *unifi.NewFlexInt(float64(time.Now().Add(-time.Hour).Unix()))
+	old := *unifi.NewFlexInt(float64(time.Now().Add(-45 * 24 * time.Hour).Unix()))
+
+	configs := []*unifi.DynamicDNS{
+		{HostName: "home.example.com", Interface: "wan"},
+		{HostName: "backup.example.com", Interface: "wan2"},
+		{HostName: "new.example.com", Interface: "wan"},
+		{HostName: "lte.example.com", Interface: "wan2"},
+		nil,
+	}
+	statuses := []*unifi.DynamicDNSStatus{
+		{HostName: "HOME.example.com", Interface: "wan", IP: "203.0.113.10", LastChanged: recent},
+		// Updated to wan1's address: out of sync on a multi-WAN site.
+		{HostName: "backup.example.com", Interface: "wan2", IP: "203.0.113.10"},
+		{HostName: "lte.example.com", Interface: "wan2", IP: "198.51.100.7", LastChanged: old},
+	}
+	devices := &unifi.Devices{UDMs: []*unifi.UDM{{
+		Wan1: unifi.Wan{IP: "203.0.113.10"},
+		Wan2: unifi.Wan{IP: "198.51.100.7"},
+	}}}
+	health := []*unifi.SubsystemHealth{
+		{Subsystem: unifi.SubsystemWWW},
+		{Subsystem: unifi.SubsystemWAN, WanIP: "192.0.2.1"},
+	}
+	sysinfo := &unifi.Sysinfo{IPAddrs: []string{"192.168.1.1", "192.0.2.2", "fe80::1"}}
+
+	wanIPs := unifi.DynamicDNSWANIPs(devices, health, sysinfo)
+	require.Equal(t, map[string][]string{"wan": {"203.0.113.10"}, "wan2": {"198.51.100.7"}}, wanIPs,
+		"gateway uplinks win over site health and sysinfo")
+
+	checks := unifi.CheckDynamicDNS(configs, statuses, wanIPs)
+	require.Len(t, checks, 4)
+
+	require.Equal(t, unifi.DynamicDNSInSync, checks[0].Status)
+	require.False(t, checks[0].OutOfSync())
+	require.False(t, checks[0].Stale)
+	require.InDelta(t, time.Hour.Seconds(), checks[0].UpdateAge.Seconds(), 60)
+
+	require.Equal(t, unifi.DynamicDNSOutOfSync, checks[1].Status)
+	require.True(t, checks[1].OutOfSync())
+	require.Equal(t, "203.0.113.10", checks[1].ReportedIP)
+	require.Contains(t, checks[1].Description, "198.51.100.7")
+	require.Zero(t, checks[1].UpdateAge)
+
+	require.Equal(t, unifi.DynamicDNSUnknown, checks[2].Status)
+	require.Nil(t, checks[2].Update)
+
+	require.Equal(t, unifi.DynamicDNSInSync, checks[3].Status)
+	require.True(t, checks[3].Stale)
+	require.Contains(t, checks[3].Description, "ago")
+
+	// Without gateways, site health and then public sysinfo addresses are used for wan.
+	require.Equal(t, []string{"192.0.2.1"}, unifi.DynamicDNSWANIPs(nil, health, sysinfo)["wan"])
+	require.Equal(t, []string{"192.0.2.2"}, unifi.DynamicDNSWANIPs(nil, nil, sysinfo)["wan"],
+		"private and link-local sysinfo addresses are ignored")
+
+	// Without any public WAN address the result is unknown rather than out of sync.
+	checks = unifi.CheckDynamicDNS(configs[:1], statuses, unifi.DynamicDNSWANIPs(nil, nil, &unifi.Sysinfo{IPAddrs: []string{"10.0.0.1"}}))
+	require.Equal(t, unifi.DynamicDNSUnknown, checks[0].Status)
+	require.Equal(t, "203.0.113.10", checks[0].ReportedIP)
+}
@@ -0,0 +1,204 @@
+package unifi
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+// GetBGPConfig returns BGP configurations for a single site.
+// Only UXG-Pro, UDM-SE and similar gateways support BGP. A controller that answers
+// 404 (ErrEndpointNotFound) has no BGP support and yields an empty slice.
+// Uses the v2 API endpoint: GET /proxy/network/v2/api/site/{site}/bgp/config/all
+func (u *Unifi) GetBGPConfig(site *Site) ([]*BGPConfig, error) {
+	if site == nil || site.Name == "" {
+		return nil, ErrNoSiteProvided
+	}
+
+	u.DebugLog("Polling Controller for BGP config, site %s", site.SiteName)
+
+	body, err := u.GetJSON(fmt.Sprintf(APIBGPConfigPath, site.Name))
+	if errors.Is(err, ErrEndpointNotFound) {
+		return []*BGPConfig{}, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("failed to fetch BGP config for site %s: %w", site.SiteName, err)
+	}
+
+	var raw []*BGPConfig
+	if err := json.Unmarshal(body, &raw); err != nil {
+		return nil, fmt.Errorf("failed to parse BGP config for site %s: %w", site.SiteName, err)
+	}
+
+	configs := make([]*BGPConfig, 0, len(raw))
+
+	for _, c := range raw {
+		if c == nil {
+			continue
+		}
+
+		c.SiteName = site.SiteName
+		c.SourceName = u.URL
+		configs = append(configs, c)
+	}
+
+	return configs, nil
+}
+
+// GetOSPFRouters returns OSPF router configurations for a single site.
+// A controller that answers 404 (ErrEndpointNotFound) has no OSPF support and yields an empty slice.
+// Uses the v2 API endpoint: GET /proxy/network/v2/api/site/{site}/ospf/router
+func (u *Unifi) GetOSPFRouters(site *Site) ([]*OSPFRouter, error) {
+	if site == nil || site.Name == "" {
+		return nil, ErrNoSiteProvided
+	}
+
+	u.DebugLog("Polling Controller for OSPF routers, site %s", site.SiteName)
+
+	body, err := u.GetJSON(fmt.Sprintf(APIOSPFRouterPath, site.Name))
+	if errors.Is(err, ErrEndpointNotFound) {
+		return []*OSPFRouter{}, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("failed to fetch OSPF routers for site %s: %w", site.SiteName, err)
+	}
+
+	var raw []*OSPFRouter
+	if err := json.Unmarshal(body, &raw); err != nil {
+		return nil, fmt.Errorf("failed to parse OSPF routers for site %s: %w", site.SiteName, err)
+	}
+
+	routers := make([]*OSPFRouter, 0, len(raw))
+
+	for _, r := range raw {
+		if r == nil {
+			continue
+		}
+
+		r.SiteName = site.SiteName
+		r.SourceName = u.URL
+		routers = append(routers, r)
+	}
+
+	return routers, nil
+}
+
+// BGPConfig represents a BGP configuration applied to a gateway.
+// Config holds the uploaded FRR configuration text when the controller provides it.
+type BGPConfig struct {
+	ID            string        `fake:"{uuid}"        json:"_id"`
+	ASNumber      FlexInt       `json:"as_number"`
+	Config        string        `json:"config,omitempty"`
+	Description   string        `fake:"{sentence:5}"  json:"description"`
+	Enabled       FlexBool      `json:"enabled"`
+	Name          string        `fake:"{buzzword}"    json:"name"`
+	Neighbors     []BGPNeighbor `fakesize:"2"         json:"neighbors"`
+	Networks      []string      `fakesize:"2"         json:"networks"` // advertised prefixes
+	RouterID      string        `fake:"{ipv4address}" json:"router_id"`
+	TargetDevices []string      `json:"target_devices"` // gateway MACs or device IDs
+	UploadedFile  string        `json:"uploaded_file_name,omitempty"`
+
+	SiteName   string `json:"-"`
+	SourceName string `json:"-"`
+}
+
+// BGPNeighbor represents a BGP peer within a BGPConfig.
+type BGPNeighbor struct {
+	Description  string   `fake:"{buzzword}"    json:"description"`
+	Enabled      FlexBool `json:"enabled"`
+	IPAddress    string   `fake:"{ipv4address}" json:"ip_address"`
+	RemoteAS     FlexInt  `json:"remote_as"`
+	State        string   `json:"state,omitempty"` // Established, Idle, Active, etc. when reported
+	UpdateSource string   `json:"update_source,omitempty"`
+}
+
+// Active reports whether the configuration is enabled and has neighbors or an uploaded config.
+func (b *BGPConfig) Active() bool {
+	return b.Enabled.Val && (len(b.Neighbors) > 0 || b.Config != "")
+}
+
+// OSPFRouter represents an OSPF router process configured on a gateway.
+type OSPFRouter struct {
+	ID                           string          `fake:"{uuid}"        json:"_id"`
+	Areas                        []OSPFArea      `fakesize:"2"         json:"areas"`
+	DefaultInformationMetricType FlexInt         `json:"default_information_metric_type,omitempty"`
+	DefaultInformationOriginate  FlexBool        `json:"default_information_originate"`
+	Enabled                      FlexBool        `json:"enabled"`
+	Interfaces                   []OSPFInterface `fakesize:"2"         json:"interfaces"`
+	Name                         string          `fake:"{buzzword}"    json:"name"`
+	RedistributeConnected        FlexBool        `json:"redistribute_connected"`
+	RedistributeStatic           FlexBool        `json:"redistribute_static"`
+	RouterID                     string          `fake:"{ipv4address}" json:"router_id"`
+	TargetDevices                []string        `json:"target_devices"`
+
+	SiteName   string `json:"-"`
+	SourceName string `json:"-"`
+}
+
+// OSPFArea represents an OSPF area definition.
+type OSPFArea struct {
+	AreaID   string   `fake:"{ipv4address}"                     json:"area_id"`
+	Networks []string `fakesize:"2"                             json:"networks"`
+	Type     string   `fake:"{randomstring:[normal,stub,nssa]}" json:"type"`
+}
+
+// OSPFInterface represents an interface participating in OSPF.
+// NetworkID references Network.ID.
+type OSPFInterface struct {
+	AreaID        string   `fake:"{ipv4address}" json:"area_id"`
+	Cost          FlexInt  `json:"cost"`
+	DeadInterval  FlexInt  `json:"dead_interval"`
+	HelloInterval FlexInt  `json:"hello_interval"`
+	NetworkID     string   `fake:"{uuid}"        json:"network_id"`
+	Passive       FlexBool `json:"passive"`
+}
+
+// Active reports whether the router is enabled and has at least one area.
+func (o *OSPFRouter) Active() bool {
+	return o.Enabled.Val && len(o.Areas) > 0
+}
+
+// DynamicRoutingStatus summarizes the BGP and OSPF configuration of a site.
+// BGPErr and OSPFErr hold the fetch errors; the counts are zero when they are set.
+type DynamicRoutingStatus struct {
+	BGPConfigs  int
+	BGPActive   int
+	BGPErr      error
+	OSPFRouters int
+	OSPFActive  int
+	OSPFErr     error
+}
+
+// Active reports whether any BGP configuration or OSPF router is active.
+func (s *DynamicRoutingStatus) Active() bool {
+	return s.BGPActive > 0 || s.OSPFActive > 0
+}
+
+// GetDynamicRoutingStatus fetches the BGP configurations and OSPF routers of a
+// site and counts the active ones. Fetch errors are recorded, not returned, so a
+// report can still show the protocol that worked.
+func (u *Unifi) GetDynamicRoutingStatus(site *Site) *DynamicRoutingStatus {
+	status := &DynamicRoutingStatus{}
+
+	bgp, err := u.GetBGPConfig(site)
+	status.BGPErr = err
+
+	for _, c := range bgp {
+		status.BGPConfigs++
+
+		if c.Active() {
+			status.BGPActive++
+		}
+	}
+
+	ospf, err := u.GetOSPFRouters(site)
+	status.OSPFErr = err
+
+	for _, r := range ospf {
+		status.OSPFRouters++
+
+		if r.Active() {
+			status.OSPFActive++
+		}
+	}
+
+	return status
+}
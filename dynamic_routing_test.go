@@ -0,0 +1,87 @@
+package unifi_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/stretchr/testify/require"
+	"github.com/unpoller/unifi/v5"
+)
+
+func TestBGPConfigStruct(t *testing.T) {
+	t.Parallel()
+
+	var c unifi.BGPConfig
+
+	err := gofakeit.Struct(&c)
+	require.NoError(t, err)
+	require.NotEmpty(t, c.ID)
+	require.NotEmpty(t, c.Neighbors)
+}
+
+func TestOSPFRouterStruct(t *testing.T) {
+	t.Parallel()
+
+	var r unifi.OSPFRouter
+
+	err := gofakeit.Struct(&r)
+	require.NoError(t, err)
+	require.NotEmpty(t, r.ID)
+	require.NotEmpty(t, r.Areas)
+}
+
+func TestDynamicRoutingActive(t *testing.T) {
+	t.Parallel()
+
+	bgp := &unifi.BGPConfig{Enabled: *unifi.NewFlexBool(true)}
+	require.False(t, bgp.Active())
+
+	bgp.Neighbors = []unifi.BGPNeighbor{{IPAddress: "10.0.0.1"}}
+	require.True(t, bgp.Active())
+
+	ospf := &unifi.OSPFRouter{Areas: []unifi.OSPFArea{{AreaID: "0.0.0.0"}}}
+	require.False(t, ospf.Active())
+
+	ospf.Enabled = *unifi.NewFlexBool(true)
+	require.True(t, ospf.Active())
+}
+
+func TestDiscoverDynamicRouting(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/proxy/network/v2/api/site/default/ospf/router" {
+			_, _ = w.Write([]byte(`[{"_id":"o1","enabled":true,"areas":[{"area_id":"0.0.0.0"}]}]`))
+
+			return
+		}
+
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer srv.Close()
+
+	discard := func(string, ...any) {}
+	u := &unifi.Unifi{
+		Client: &http.Client{},
+		Config: &unifi.Config{URL: srv.URL, DebugLog: discard, ErrorLog: discard},
+	}
+
+	status := u.GetDynamicRoutingStatus(&unifi.Site{Name: "default"})
+	require.NoError(t, status.BGPErr, "404 means BGP is unsupported, not an error")
+	require.NoError(t, status.OSPFErr)
+	require.Zero(t, status.BGPConfigs)
+	require.Equal(t, 1, status.OSPFActive)
+	require.True(t, status.Active())
+
+	out := filepath.Join(t.TempDir(), "report.md")
+	require.NoError(t, u.DiscoverEndpoints("default", out))
+
+	report, err := os.ReadFile(out)
+	require.NoError(t, err)
+	require.Contains(t, string(report), "- **BGP**: not configured")
+	require.Contains(t, string(report), "- **OSPF**: active (1 of 1 routers)")
+}
@@ -38,6 +38,10 @@ func (u *Unifi) GetSiteEvents(site *Site, hours time.Duration) ([]*Event, error)
 		return nil, ErrNoSiteProvided
 	}
 
+	if err := u.requireLegacyEvents(site, "stat/event"); err != nil {
+		return nil, err
+	}
+
 	if hours < time.Hour {
 		hours = time.Hour
 	}
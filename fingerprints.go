@@ -0,0 +1,486 @@
+package unifi
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// fingerprintPages are the fingerprint_devices pages the controller serves:
+// 0 is the built-in database and 1 holds additions and overrides.
+var fingerprintPages = []int{0, 1} // nolint: gochecknoglobals
+
+// FingerprintNames maps fingerprint table IDs to names. The controller sends
+// either plain strings or objects with a name; both are accepted.
+type FingerprintNames map[string]string
+
+// UnmarshalJSON accepts {"1":"Apple"} and {"1":{"name":"Apple"}}.
+func (n *FingerprintNames) UnmarshalJSON(b []byte) error {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(b, &raw); err != nil {
+		return fmt.Errorf("json unmarshal: %w", err)
+	}
+
+	*n = make(FingerprintNames, len(raw))
+
+	for id, v := range raw {
+		var name string
+		if err := json.Unmarshal(v, &name); err == nil {
+			(*n)[id] = name
+
+			continue
+		}
+
+		var obj struct {
+			Name string `json:"name"`
+		}
+
+		if err := json.Unmarshal(v, &obj); err != nil {
+			return fmt.Errorf("json unmarshal %s: %w", id, err)
+		}
+
+		(*n)[id] = obj.Name
+	}
+
+	return nil
+}
+
+// FingerprintDevice is an entry of the fingerprint database: a device model such
+// as "Apple iPhone" with the default type, family, vendor and OS IDs for it.
+type FingerprintDevice struct {
+	Name      string  `fake:"{appname}" json:"name"`
+	DevTypeID FlexInt `json:"dev_type_id"`
+	FamilyID  FlexInt `json:"family_id"`
+	OSClassID FlexInt `json:"os_class_id"`
+	OSNameID  FlexInt `json:"os_name_id"`
+	VendorID  FlexInt `json:"vendor_id"`
+}
+
+// FingerprintDatabase is one page of /v2/api/fingerprint_devices/{n}. Keys of
+// every map are the numeric IDs found in client dev_id, dev_cat, dev_family,
+// dev_vendor, os_class and os_name fields.
+type FingerprintDatabase struct {
+	DevIDs     map[string]*FingerprintDevice `json:"dev_ids"`
+	DevTypeIDs FingerprintNames              `json:"dev_type_ids"`
+	FamilyIDs  FingerprintNames              `json:"family_ids"`
+	OSClassIDs FingerprintNames              `json:"os_class_ids"`
+	OSNameIDs  FingerprintNames              `json:"os_name_ids"`
+	VendorIDs  FingerprintNames              `json:"vendor_ids"`
+}
+
+// VendorID is a vendor name for a client dev_vendor ID from /v2/api/site/{site}/vendor-ids.
+type VendorID struct {
+	ID   FlexInt `json:"id"`
+	Name string  `fake:"{company}" json:"name"`
+}
+
+// FingerprintSnapshot holds everything Fingerprints resolves from. Save one with
+// json.Marshal(f.Snapshot()) and load it with LoadFingerprints to resolve clients
+// without a controller, e.g. in tests.
+type FingerprintSnapshot struct {
+	Databases []*FingerprintDatabase `json:"fingerprint_devices"`
+	VendorIDs []*VendorID            `json:"vendor_ids"`
+}
+
+// FingerprintIDs are the fingerprint fields of a client. Zero IDs are unknown.
+// OUI is the vendor name the controller derived from the MAC address.
+type FingerprintIDs struct {
+	DevID   int
+	DevType int
+	Family  int
+	Vendor  int
+	OSName  int
+	OSClass int
+	OUI     string
+}
+
+// DeviceFingerprint is a client resolved to names. Empty fields are unknown.
+type DeviceFingerprint struct {
+	DevID   int
+	Name    string // device model, e.g. "Apple iPhone"
+	Type    string // e.g. "Smartphone"
+	Family  string
+	Vendor  string
+	OS      string
+	OSClass string
+}
+
+// String returns the device name, or the vendor and family when the model is unknown.
+func (d *DeviceFingerprint) String() string {
+	if d == nil {
+		return ""
+	}
+
+	return pick(d.Name, strings.TrimSpace(d.Vendor+" "+d.Family), d.Type)
+}
+
+// Fingerprints resolves client fingerprint IDs to vendor, device family and OS
+// names. Build one with NewFingerprints or LoadFingerprints, or get the cached
+// copy from Unifi.Fingerprints. It is read-only and safe for concurrent use.
+type Fingerprints struct {
+	snapshot  *FingerprintSnapshot
+	devices   map[int]*FingerprintDevice
+	devTypes  map[int]string
+	families  map[int]string
+	osClasses map[int]string
+	osNames   map[int]string
+	vendors   map[int]string
+}
+
+// NewFingerprints indexes a snapshot. Later databases override earlier ones,
+// and vendor IDs fill in vendors the databases do not name.
+func NewFingerprints(snapshot *FingerprintSnapshot) *Fingerprints {
+	if snapshot == nil {
+		snapshot = &FingerprintSnapshot{}
+	}
+
+	f := &Fingerprints{
+		snapshot:  snapshot,
+		devices:   make(map[int]*FingerprintDevice),
+		devTypes:  make(map[int]string),
+		families:  make(map[int]string),
+		osClasses: make(map[int]string),
+		osNames:   make(map[int]string),
+		vendors:   make(map[int]string),
+	}
+
+	for _, db := range snapshot.Databases {
+		if db == nil {
+			continue
+		}
+
+		for id, dev := range db.DevIDs {
+			if n, err := strconv.Atoi(id); err == nil && dev != nil {
+				f.devices[n] = dev
+			}
+		}
+
+		indexFingerprintNames(f.devTypes, db.DevTypeIDs)
+		indexFingerprintNames(f.families, db.FamilyIDs)
+		indexFingerprintNames(f.osClasses, db.OSClassIDs)
+		indexFingerprintNames(f.osNames, db.OSNameIDs)
+		indexFingerprintNames(f.vendors, db.VendorIDs)
+	}
+
+	for _, v := range snapshot.VendorIDs {
+		if v == nil || v.Name == "" {
+			continue
+		}
+
+		if _, ok := f.vendors[v.ID.Int()]; !ok {
+			f.vendors[v.ID.Int()] = v.Name
+		}
+	}
+
+	return f
+}
+
+// LoadFingerprints reads a FingerprintSnapshot saved as JSON.
+func LoadFingerprints(r io.Reader) (*Fingerprints, error) {
+	var snapshot FingerprintSnapshot
+	if err := json.NewDecoder(r).Decode(&snapshot); err != nil {
+		return nil, fmt.Errorf("decoding fingerprint snapshot: %w", err)
+	}
+
+	return NewFingerprints(&snapshot), nil
+}
+
+// Snapshot returns a copy of the data f was built from, for saving with json.Marshal.
+// Changing the copy does not affect f.
+func (f *Fingerprints) Snapshot() *FingerprintSnapshot {
+	if f == nil {
+		return &FingerprintSnapshot{}
+	}
+
+	snapshot := &FingerprintSnapshot{
+		Databases: make([]*FingerprintDatabase, 0, len(f.snapshot.Databases)),
+		VendorIDs: make([]*VendorID, 0, len(f.snapshot.VendorIDs)),
+	}
+
+	for _, db := range f.snapshot.Databases {
+		if db != nil {
+			snapshot.Databases = append(snapshot.Databases, db.clone())
+		}
+	}
+
+	for _, v := range f.snapshot.VendorIDs {
+		if v != nil {
+			vendor := *v
+			snapshot.VendorIDs = append(snapshot.VendorIDs, &vendor)
+		}
+	}
+
+	return snapshot
+}
+
+// clone returns a deep copy of the database page.
+func (db *FingerprintDatabase) clone() *FingerprintDatabase {
+	c := &FingerprintDatabase{
+		DevIDs:     make(map[string]*FingerprintDevice, len(db.DevIDs)),
+		DevTypeIDs: maps.Clone(db.DevTypeIDs),
+		FamilyIDs:  maps.Clone(db.FamilyIDs),
+		OSClassIDs: maps.Clone(db.OSClassIDs),
+		OSNameIDs:  maps.Clone(db.OSNameIDs),
+		VendorIDs:  maps.Clone(db.VendorIDs),
+	}
+
+	for id, dev := range db.DevIDs {
+		if dev != nil {
+			d := *dev
+			c.DevIDs[id] = &d
+		}
+	}
+
+	return c
+}
+
+// Resolve names a client's fingerprint IDs. IDs the client does not set are taken
+// from its device entry, and the vendor falls back to the OUI name.
+func (f *Fingerprints) Resolve(ids FingerprintIDs) *DeviceFingerprint {
+	result := &DeviceFingerprint{DevID: ids.DevID, Vendor: ids.OUI}
+
+	if f == nil {
+		return result
+	}
+
+	dev := f.devices[ids.DevID]
+	if dev == nil {
+		dev = &FingerprintDevice{}
+	}
+
+	result.Name = dev.Name
+	result.Type = f.devTypes[firstID(ids.DevType, dev.DevTypeID.Int())]
+	result.Family = f.families[firstID(ids.Family, dev.FamilyID.Int())]
+	result.OS = f.osNames[firstID(ids.OSName, dev.OSNameID.Int())]
+	result.OSClass = f.osClasses[firstID(ids.OSClass, dev.OSClassID.Int())]
+	result.Vendor = pick(f.vendors[firstID(ids.Vendor, dev.VendorID.Int())], ids.OUI)
+
+	return result
+}
+
+// ResolveClient names a connected client's fingerprint.
+func (f *Fingerprints) ResolveClient(c *Client) *DeviceFingerprint {
+	if c == nil {
+		return nil
+	}
+
+	return f.Resolve(FingerprintIDs{
+		DevID:   c.DevID.Int(),
+		DevType: c.DevCat.Int(),
+		Family:  c.DevFamily.Int(),
+		Vendor:  c.DevVendor.Int(),
+		OSName:  c.OsName.Int(),
+		OSClass: c.OsClass.Int(),
+		OUI:     c.Oui,
+	})
+}
+
+// ResolveClientInfo names the fingerprint of a client from the traffic API. A
+// user override wins over the detected and computed device IDs.
+func (f *Fingerprints) ResolveClientInfo(c *ClientInfo) *DeviceFingerprint {
+	if c == nil {
+		return nil
+	}
+
+	fp := c.Fingerprint
+
+	return f.Resolve(FingerprintIDs{
+		DevID:   firstID(fp.DevIDOverride, fp.DevID, fp.ComputedDevID),
+		DevType: fp.DevCat,
+		Family:  fp.DevFamily,
+		Vendor:  fp.DevVendor,
+		OSName:  fp.OsName,
+		OSClass: fp.OsClass,
+		OUI:     c.Oui,
+	})
+}
+
+// GetFingerprintDatabase returns one page of the client fingerprint database.
+// Uses the v2 API endpoint: GET /proxy/network/v2/api/fingerprint_devices/{page}
+func (u *Unifi) GetFingerprintDatabase(page int) (*FingerprintDatabase, error) {
+	u.DebugLog("Polling Controller for fingerprint database page %d", page)
+
+	body, err := u.GetJSON(fmt.Sprintf(APIFingerprintDevicesPath, page))
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch fingerprint database page %d: %w", page, err)
+	}
+
+	var db FingerprintDatabase
+	if err := json.Unmarshal(body, &db); err != nil {
+		return nil, fmt.Errorf("failed to parse fingerprint database page %d: %w", page, err)
+	}
+
+	return &db, nil
+}
+
+// GetVendorIDs returns the vendor names behind client dev_vendor IDs for a site.
+// Uses the v2 API endpoint: GET /proxy/network/v2/api/site/{site}/vendor-ids
+func (u *Unifi) GetVendorIDs(site *Site) ([]*VendorID, error) {
+	if site == nil || site.Name == "" {
+		return nil, ErrNoSiteProvided
+	}
+
+	u.DebugLog("Polling Controller for vendor IDs, site %s", site.SiteName)
+
+	body, err := u.GetJSON(fmt.Sprintf(APIVendorIDsPath, site.Name))
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch vendor IDs for site %s: %w", site.SiteName, err)
+	}
+
+	var list []*VendorID
+	if err := json.Unmarshal(body, &list); err == nil {
+		vendors := make([]*VendorID, 0, len(list))
+
+		for _, v := range list {
+			if v != nil {
+				vendors = append(vendors, v)
+			}
+		}
+
+		return vendors, nil
+	}
+
+	// Some controllers return an object of ID to name instead of a list.
+	var names FingerprintNames
+	if err := json.Unmarshal(body, &names); err != nil {
+		return nil, fmt.Errorf("failed to parse vendor IDs for site %s: %w", site.SiteName, err)
+	}
+
+	vendors := make([]*VendorID, 0, len(names))
+
+	for id, name := range names {
+		if n, err := strconv.Atoi(id); err == nil {
+			vendors = append(vendors, &VendorID{ID: *NewFlexInt(float64(n)), Name: name})
+		}
+	}
+
+	return vendors, nil
+}
+
+// fingerprintRetry is how long a failed or partial fingerprint load is kept
+// before Fingerprints asks the controller again.
+const fingerprintRetry = 5 * time.Minute
+
+// fingerprintEntry is the cached fingerprint load. A zero retryAt never expires.
+type fingerprintEntry struct {
+	fps     *Fingerprints
+	err     error
+	retryAt time.Time
+}
+
+// Fingerprints loads the fingerprint database and vendor IDs once and returns the
+// cached result on later calls, for any site. The fingerprint tables are
+// controller-wide, but the vendor IDs are read from the site passed on the first
+// call and reused for every other site. Page 1 of the database and the vendor IDs
+// are optional: a missing endpoint is skipped, while any other failure is
+// debug-logged and the partial result is cached for a few minutes before the
+// controller is asked again. A failed reload keeps the earlier partial result.
+// Use SetFingerprints to provide an offline snapshot.
+func (u *Unifi) Fingerprints(site *Site) (*Fingerprints, error) {
+	if site == nil || site.Name == "" {
+		return nil, ErrNoSiteProvided
+	}
+
+	u.fingerprintDBMu.Lock()
+	entry := u.fingerprintDB
+	u.fingerprintDBMu.Unlock()
+
+	if entry != nil && (entry.retryAt.IsZero() || time.Now().Before(entry.retryAt)) {
+		return entry.fps, entry.err
+	}
+
+	fps, complete, err := u.loadFingerprints(site)
+
+	u.fingerprintDBMu.Lock()
+	defer u.fingerprintDBMu.Unlock()
+
+	// SetFingerprints or another caller may have stored a complete result meanwhile.
+	if cached := u.fingerprintDB; cached != nil && cached.fps != nil && cached.retryAt.IsZero() {
+		return cached.fps, nil
+	}
+
+	switch {
+	case err == nil && complete:
+		u.fingerprintDB = &fingerprintEntry{fps: fps}
+	case err == nil:
+		u.fingerprintDB = &fingerprintEntry{fps: fps, retryAt: time.Now().Add(fingerprintRetry)}
+	case u.fingerprintDB != nil && u.fingerprintDB.fps != nil:
+		u.fingerprintDB.retryAt = time.Now().Add(fingerprintRetry)
+	default:
+		u.fingerprintDB = &fingerprintEntry{err: err, retryAt: time.Now().Add(fingerprintRetry)}
+	}
+
+	return u.fingerprintDB.fps, u.fingerprintDB.err
+}
+
+// loadFingerprints requests the fingerprint database pages and a site's vendor IDs.
+// complete is false when an optional piece failed for a reason other than a missing endpoint.
+func (u *Unifi) loadFingerprints(site *Site) (*Fingerprints, bool, error) {
+	snapshot := &FingerprintSnapshot{}
+	complete := true
+
+	for _, page := range fingerprintPages {
+		db, err := u.GetFingerprintDatabase(page)
+		if err != nil && page == fingerprintPages[0] {
+			return nil, false, err
+		} else if err != nil {
+			u.DebugLog("Fingerprint database page %d unavailable: %v", page, err)
+			complete = complete && errors.Is(err, ErrEndpointNotFound)
+
+			continue
+		}
+
+		snapshot.Databases = append(snapshot.Databases, db)
+	}
+
+	vendors, err := u.GetVendorIDs(site)
+	if err != nil {
+		u.DebugLog("Vendor IDs unavailable, site %s: %v", site.SiteName, err)
+		complete = complete && errors.Is(err, ErrEndpointNotFound)
+	}
+
+	snapshot.VendorIDs = vendors
+
+	return NewFingerprints(snapshot), complete, nil
+}
+
+// SetFingerprints replaces the cached fingerprint tables, e.g. with a snapshot
+// from LoadFingerprints, so Fingerprints does not query the controller. A nil
+// f drops the cache and the next Fingerprints call loads from the controller.
+func (u *Unifi) SetFingerprints(f *Fingerprints) {
+	u.fingerprintDBMu.Lock()
+	defer u.fingerprintDBMu.Unlock()
+
+	if f == nil {
+		u.fingerprintDB = nil
+
+		return
+	}
+
+	u.fingerprintDB = &fingerprintEntry{fps: f}
+}
+
+// indexFingerprintNames copies names with numeric IDs into index.
+func indexFingerprintNames(index map[int]string, names FingerprintNames) {
+	for id, name := range names {
+		if n, err := strconv.Atoi(id); err == nil && name != "" {
+			index[n] = name
+		}
+	}
+}
+
+// firstID returns the first non-zero ID.
+func firstID(ids ...int) int {
+	for _, id := range ids {
+		if id != 0 {
+			return id
+		}
+	}
+
+	return 0
+}
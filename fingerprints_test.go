@@ -0,0 +1,191 @@
+package unifi // nolint: testpackage
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFingerprints(t *testing.T) {
+	t.Parallel()
+
+	var (
+		mu    sync.Mutex
+		calls = make(map[string]int)
+	)
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		calls[r.URL.Path]++
+		mu.Unlock()
+
+		switch r.URL.Path {
+		case "/proxy/network/v2/api/fingerprint_devices/0":
+			_, _ = w.Write([]byte(`{
+				"dev_ids": {"4": {"name": "Apple iPhone", "dev_type_id": "9", "family_id": 2, "vendor_id": 7, "os_name_id": 56}},
+				"dev_type_ids": {"9": "Smartphone"},
+				"family_ids": {"2": {"name": "Handheld"}},
+				"os_name_ids": {"56": "iOS"},
+				"os_class_ids": {"15": "Apple iOS"}
+			}`))
+		case "/proxy/network/v2/api/site/default/vendor-ids":
+			_, _ = w.Write([]byte(`{"7": "Apple", "8": "Samsung"}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer srv.Close()
+
+	u := &Unifi{
+		Client: &http.Client{},
+		Config: &Config{URL: srv.URL, DebugLog: discardLogs, ErrorLog: discardLogs},
+	}
+	site := &Site{Name: "default"}
+
+	fps, err := u.Fingerprints(site)
+	require.NoError(t, err)
+
+	got := fps.ResolveClient(&Client{DevID: *NewFlexInt(4), OsClass: *NewFlexInt(15), Oui: "AppleInc"})
+	assert.Equal(t, &DeviceFingerprint{
+		DevID: 4, Name: "Apple iPhone", Type: "Smartphone", Family: "Handheld",
+		Vendor: "Apple", OS: "iOS", OSClass: "Apple iOS",
+	}, got)
+	assert.Equal(t, "Apple iPhone", got.String())
+
+	info := fps.ResolveClientInfo(&ClientInfo{Oui: "SamsungE", Fingerprint: Fingerprint{DevID: 99, DevVendor: 8, DevFamily: 2}})
+	assert.Equal(t, "Samsung Handheld", info.String())
+	assert.Equal(t, "Raspberry", fps.Resolve(FingerprintIDs{OUI: "Raspberry"}).Vendor)
+
+	again, err := u.Fingerprints(&Site{Name: "other"})
+	require.NoError(t, err)
+	assert.Same(t, fps, again)
+
+	mu.Lock()
+	assert.Equal(t, 1, calls["/proxy/network/v2/api/fingerprint_devices/0"], "tables should be cached")
+	assert.Zero(t, calls["/proxy/network/v2/api/site/other/vendor-ids"])
+	mu.Unlock()
+
+	// Snapshot returns a copy; changing it leaves the cached tables alone.
+	snap := fps.Snapshot()
+	snap.Databases[0].DevIDs["4"].Name = "Changed"
+	snap.VendorIDs = nil
+
+	assert.Equal(t, "Apple iPhone", fps.Snapshot().Databases[0].DevIDs["4"].Name)
+	assert.NotEmpty(t, fps.Snapshot().VendorIDs)
+
+	// An offline snapshot resolves the same way without a controller.
+	saved, err := json.Marshal(fps.Snapshot())
+	require.NoError(t, err)
+
+	offline, err := LoadFingerprints(bytes.NewReader(saved))
+	require.NoError(t, err)
+
+	noController := &Unifi{Config: &Config{DebugLog: discardLogs, ErrorLog: discardLogs}}
+	noController.SetFingerprints(offline)
+
+	cached, err := noController.Fingerprints(site)
+	require.NoError(t, err)
+	assert.Equal(t, got, cached.ResolveClient(&Client{DevID: *NewFlexInt(4), OsClass: *NewFlexInt(15)}))
+}
+
+func TestFingerprintsTransientError(t *testing.T) {
+	t.Parallel()
+
+	var (
+		mu      sync.Mutex
+		vendors int
+	)
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/proxy/network/v2/api/fingerprint_devices/0":
+			_, _ = w.Write([]byte(`{"dev_ids": {"4": {"name": "Apple iPhone", "vendor_id": 7}}}`))
+		case "/proxy/network/v2/api/site/default/vendor-ids":
+			mu.Lock()
+			defer mu.Unlock()
+
+			if vendors++; vendors == 1 {
+				w.WriteHeader(http.StatusBadGateway)
+
+				return
+			}
+
+			_, _ = w.Write([]byte(`{"7": "Apple"}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer srv.Close()
+
+	u := &Unifi{
+		Client: &http.Client{},
+		Config: &Config{URL: srv.URL, DebugLog: discardLogs, ErrorLog: discardLogs},
+	}
+	site := &Site{Name: "default"}
+
+	partial, err := u.Fingerprints(site)
+	require.NoError(t, err)
+	assert.Empty(t, partial.Resolve(FingerprintIDs{DevID: 4}).Vendor)
+
+	cached, err := u.Fingerprints(site)
+	require.NoError(t, err)
+	assert.Same(t, partial, cached, "a partial result is kept until it expires")
+
+	u.fingerprintDBMu.Lock()
+	u.fingerprintDB.retryAt = time.Now().Add(-time.Second)
+	u.fingerprintDBMu.Unlock()
+
+	fps, err := u.Fingerprints(site)
+	require.NoError(t, err)
+	assert.NotSame(t, partial, fps, "an expired partial result is loaded again")
+	assert.Equal(t, "Apple", fps.Resolve(FingerprintIDs{DevID: 4}).Vendor)
+
+	again, err := u.Fingerprints(site)
+	require.NoError(t, err)
+	assert.Same(t, fps, again)
+
+	mu.Lock()
+	assert.Equal(t, 2, vendors)
+	mu.Unlock()
+}
+
+func TestFingerprintsDatabaseError(t *testing.T) {
+	t.Parallel()
+
+	var calls atomic.Int32
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		calls.Add(1)
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer srv.Close()
+
+	u := &Unifi{
+		Client: &http.Client{},
+		Config: &Config{URL: srv.URL, DebugLog: discardLogs, ErrorLog: discardLogs},
+	}
+	site := &Site{Name: "default"}
+
+	for range 3 {
+		_, err := u.Fingerprints(site)
+		require.ErrorIs(t, err, ErrInvalidStatusCode)
+	}
+
+	assert.Equal(t, int32(1), calls.Load(), "a failed load must not be repeated before it expires")
+
+	offline := NewFingerprints(&FingerprintSnapshot{})
+	u.SetFingerprints(offline)
+
+	fps, err := u.Fingerprints(site)
+	require.NoError(t, err)
+	assert.Same(t, offline, fps)
+	assert.Equal(t, int32(1), calls.Load())
+}
@@ -0,0 +1,198 @@
+package unifi
+
+import "fmt"
+
+// Firewall group types reported in FirewallGroup.GroupType.
+const (
+	FirewallGroupTypeAddress     = "address-group"
+	FirewallGroupTypeIPv6Address = "ipv6-address-group"
+	FirewallGroupTypePort        = "port-group"
+)
+
+// FirewallGroup represents a legacy firewall group from /api/s/{site}/rest/firewallgroup.
+// Members are IP addresses/subnets for address groups and ports/ranges for port groups.
+type FirewallGroup struct {
+	ID           string   `fake:"{uuid}"                                                       json:"_id"`
+	GroupMembers []string `fakesize:"3"                                                        json:"group_members"`
+	GroupType    string   `fake:"{randomstring:[address-group,ipv6-address-group,port-group]}" json:"group_type"`
+	Name         string   `fake:"{buzzword}"                                                   json:"name"`
+	SiteID       string   `fake:"{uuid}"                                                       json:"site_id"`
+
+	SiteName   string `json:"-"`
+	SourceName string `json:"-"`
+}
+
+// FirewallRule represents a legacy (USG-style) firewall rule from /api/s/{site}/rest/firewallrule.
+// Zone-based firewalls on newer firmware use GetFirewallPolicies instead.
+type FirewallRule struct {
+	ID                    string   `fake:"{uuid}"                                   json:"_id"`
+	Action                string   `fake:"{randomstring:[accept,drop,reject]}"      json:"action"`
+	DstAddress            string   `json:"dst_address"`
+	DstAddressIPv6        string   `json:"dst_address_ipv6"`
+	DstFirewallGroupIDs   []string `json:"dst_firewallgroup_ids"`
+	DstNetworkconfID      string   `json:"dst_networkconf_id"`
+	DstNetworkconfType    string   `json:"dst_networkconf_type"`
+	DstPort               string   `json:"dst_port"`
+	Enabled               FlexBool `json:"enabled"`
+	ICMPTypename          string   `json:"icmp_typename"`
+	ICMPv6Typename        string   `json:"icmpv6_typename"`
+	IPSec                 string   `json:"ipsec"`
+	Logging               FlexBool `json:"logging"`
+	Name                  string   `fake:"{buzzword}"                               json:"name"`
+	Protocol              string   `fake:"{randomstring:[all,tcp,udp,tcp_udp]}"     json:"protocol"`
+	ProtocolMatchExcepted FlexBool `json:"protocol_match_excepted"`
+	ProtocolV6            string   `json:"protocol_v6"`
+	RuleIndex             FlexInt  `json:"rule_index"`
+	Ruleset               string   `fake:"{randomstring:[WAN_IN,WAN_LOCAL,LAN_IN]}" json:"ruleset"`
+	SettingPreference     string   `json:"setting_preference"`
+	SiteID                string   `fake:"{uuid}"                                   json:"site_id"`
+	SrcAddress            string   `json:"src_address"`
+	SrcAddressIPv6        string   `json:"src_address_ipv6"`
+	SrcFirewallGroupIDs   []string `json:"src_firewallgroup_ids"`
+	SrcMacAddress         string   `json:"src_mac_address"`
+	SrcNetworkconfID      string   `json:"src_networkconf_id"`
+	SrcNetworkconfType    string   `json:"src_networkconf_type"`
+	SrcPort               string   `json:"src_port"`
+	StateEstablished      FlexBool `json:"state_established"`
+	StateInvalid          FlexBool `json:"state_invalid"`
+	StateNew              FlexBool `json:"state_new"`
+	StateRelated          FlexBool `json:"state_related"`
+
+	SiteName   string `json:"-"`
+	SourceName string `json:"-"`
+}
+
+// ResolvedFirewallRule is a FirewallRule with its group references expanded
+// into the underlying address and port members.
+type ResolvedFirewallRule struct {
+	*FirewallRule
+	SrcAddresses []string
+	SrcPorts     []string
+	DstAddresses []string
+	DstPorts     []string
+	// MissingGroupIDs lists referenced group IDs that were not found in the provided groups.
+	MissingGroupIDs []string
+}
+
+// GetFirewallGroups returns legacy firewall groups for a single site.
+// Uses the legacy API endpoint: GET /api/s/{site}/rest/firewallgroup.
+func (u *Unifi) GetFirewallGroups(site *Site) ([]*FirewallGroup, error) {
+	if site == nil || site.Name == "" {
+		return nil, ErrNoSiteProvided
+	}
+
+	u.DebugLog("Polling Controller for firewall groups, site %s", site.SiteName)
+
+	path := fmt.Sprintf(APIFirewallGroupPath, site.Name)
+
+	var response struct {
+		Data []FirewallGroup `json:"data"`
+	}
+
+	if err := u.GetData(path, &response); err != nil {
+		return nil, fmt.Errorf("fetching firewall groups for site %s: %w", site.SiteName, err)
+	}
+
+	result := make([]*FirewallGroup, len(response.Data))
+
+	for i := range response.Data {
+		response.Data[i].SiteName = site.SiteName
+		response.Data[i].SourceName = u.URL
+		result[i] = &response.Data[i]
+	}
+
+	return result, nil
+}
+
+// GetFirewallRules returns legacy firewall rules for a single site.
+// Uses the legacy API endpoint: GET /api/s/{site}/rest/firewallrule.
+func (u *Unifi) GetFirewallRules(site *Site) ([]*FirewallRule, error) {
+	if site == nil || site.Name == "" {
+		return nil, ErrNoSiteProvided
+	}
+
+	u.DebugLog("Polling Controller for firewall rules, site %s", site.SiteName)
+
+	path := fmt.Sprintf(APIFirewallRulePath, site.Name)
+
+	var response struct {
+		Data []FirewallRule `json:"data"`
+	}
+
+	if err := u.GetData(path, &response); err != nil {
+		return nil, fmt.Errorf("fetching firewall rules for site %s: %w", site.SiteName, err)
+	}
+
+	result := make([]*FirewallRule, len(response.Data))
+
+	for i := range response.Data {
+		response.Data[i].SiteName = site.SiteName
+		response.Data[i].SourceName = u.URL
+		result[i] = &response.Data[i]
+	}
+
+	return result, nil
+}
+
+// ResolveFirewallRules expands the source and destination group IDs of each rule
+// into the address and port members of the referenced groups. Literal addresses
+// and ports set directly on a rule are included alongside the group members.
+func ResolveFirewallRules(rules []*FirewallRule, groups []*FirewallGroup) []*ResolvedFirewallRule {
+	byID := make(map[string]*FirewallGroup, len(groups))
+
+	for _, g := range groups {
+		if g != nil {
+			byID[g.ID] = g
+		}
+	}
+
+	resolved := make([]*ResolvedFirewallRule, 0, len(rules))
+
+	for _, rule := range rules {
+		if rule == nil {
+			continue
+		}
+
+		r := &ResolvedFirewallRule{FirewallRule: rule}
+		r.SrcAddresses, r.SrcPorts = r.expand(byID, rule.SrcFirewallGroupIDs)
+		r.DstAddresses, r.DstPorts = r.expand(byID, rule.DstFirewallGroupIDs)
+		r.SrcAddresses = appendNonEmpty(r.SrcAddresses, rule.SrcAddress, rule.SrcAddressIPv6)
+		r.SrcPorts = appendNonEmpty(r.SrcPorts, rule.SrcPort)
+		r.DstAddresses = appendNonEmpty(r.DstAddresses, rule.DstAddress, rule.DstAddressIPv6)
+		r.DstPorts = appendNonEmpty(r.DstPorts, rule.DstPort)
+		resolved = append(resolved, r)
+	}
+
+	return resolved
+}
+
+// expand returns the address and port members of the referenced groups.
+func (r *ResolvedFirewallRule) expand(byID map[string]*FirewallGroup, ids []string) (addresses, ports []string) {
+	for _, id := range ids {
+		group, ok := byID[id]
+		if !ok {
+			r.MissingGroupIDs = append(r.MissingGroupIDs, id)
+
+			continue
+		}
+
+		if group.GroupType == FirewallGroupTypePort {
+			ports = append(ports, group.GroupMembers...)
+		} else {
+			addresses = append(addresses, group.GroupMembers...)
+		}
+	}
+
+	return addresses, ports
+}
+
+// appendNonEmpty appends each non-empty value to list.
+func appendNonEmpty(list []string, values ...string) []string {
+	for _, v := range values {
+		if v != "" {
+			list = append(list, v)
+		}
+	}
+
+	return list
+}
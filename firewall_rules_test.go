@@ -0,0 +1,63 @@
+package unifi_test
+
+import (
+	"testing"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/unpoller/unifi/v5"
+)
+
+func TestFirewallGroupStruct(t *testing.T) {
+	t.Parallel()
+
+	var g unifi.FirewallGroup
+
+	err := gofakeit.Struct(&g)
+	require.NoError(t, err)
+	require.NotEmpty(t, g.ID)
+	require.NotEmpty(t, g.GroupType)
+}
+
+func TestFirewallRuleStruct(t *testing.T) {
+	t.Parallel()
+
+	var r unifi.FirewallRule
+
+	err := gofakeit.Struct(&r)
+	require.NoError(t, err)
+	require.NotEmpty(t, r.ID)
+	require.NotEmpty(t, r.Ruleset)
+}
+
+func TestResolveFirewallRules(t *testing.T) {
+	t.Parallel()
+
+	groups := []*unifi.FirewallGroup{
+		{ID: "g1", GroupType: unifi.FirewallGroupTypeAddress, GroupMembers: []string{"10.0.0.0/24", "10.0.1.5"}},
+		{ID: "g2", GroupType: unifi.FirewallGroupTypePort, GroupMembers: []string{"80", "443"}},
+		{ID: "g3", GroupType: unifi.FirewallGroupTypeIPv6Address, GroupMembers: []string{"fd00::/64"}},
+	}
+	rules := []*unifi.FirewallRule{
+		{
+			ID:                  "r1",
+			SrcFirewallGroupIDs: []string{"g1", "missing"},
+			DstFirewallGroupIDs: []string{"g3", "g2"},
+			DstAddress:          "192.168.1.10",
+		},
+		nil,
+	}
+
+	resolved := unifi.ResolveFirewallRules(rules, groups)
+	require.Len(t, resolved, 1)
+
+	a := assert.New(t)
+	r := resolved[0]
+	a.Equal("r1", r.ID)
+	a.Equal([]string{"10.0.0.0/24", "10.0.1.5"}, r.SrcAddresses)
+	a.Empty(r.SrcPorts)
+	a.Equal([]string{"fd00::/64", "192.168.1.10"}, r.DstAddresses)
+	a.Equal([]string{"80", "443"}, r.DstPorts)
+	a.Equal([]string{"missing"}, r.MissingGroupIDs)
+}
@@ -0,0 +1,312 @@
+package unifi
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// WidgetWarnings represents the dashboard warnings widget from /api/s/{site}/stat/widget/warnings.
+// Device lists hold MAC addresses.
+type WidgetWarnings struct {
+	EOLDeviceCount         FlexInt  `json:"eol_device_count"`
+	EOLDevices             []string `json:"eol_device_list"`
+	FirmwareStatus         string   `json:"firmware_status"` // e.g. "up_to_date", "upgrade_available"
+	HasEOLDevices          FlexBool `json:"has_eol_devices"`
+	HasUnsupportedDevices  FlexBool `json:"has_unsupported_devices"`
+	HasUpgradableDevices   FlexBool `json:"has_upgradable_devices"`
+	UnsupportedDeviceCount FlexInt  `json:"unsupported_device_count"`
+	UnsupportedDevices     []string `json:"unsupported_device_list"`
+	UpgradableDeviceCount  FlexInt  `json:"upgradable_device_count"`
+	UpgradableDevices      []string `json:"upgradable_device_list"`
+
+	SiteName   string `json:"-"`
+	SourceName string `json:"-"`
+}
+
+// FirmwareDevice is the firmware state of a single device in a FirmwareReport.
+type FirmwareDevice struct {
+	SiteName          string
+	Mac               string
+	Name              string
+	Model             string
+	Type              string
+	Version           string
+	UpgradeToFirmware string
+	// LatestVersion is the newest version running on any device of the same model.
+	LatestVersion string
+	// Lagging is true when the device runs an older version than LatestVersion
+	// or than the UpgradeToFirmware the controller offers.
+	Lagging bool
+	// EOL is true when the model is end of life, from the device or the warnings widget.
+	EOL bool
+	// UpgradePending is true when the controller reports an upgrade is available.
+	UpgradePending bool
+}
+
+// FirmwareSummary counts devices by firmware state, for one model or one site.
+type FirmwareSummary struct {
+	Devices        int
+	Lagging        int
+	EOL            int
+	UpgradePending int
+	// Versions counts devices per running firmware version.
+	Versions map[string]int
+}
+
+// FirmwareReport combines device firmware state with the warnings widget across sites.
+type FirmwareReport struct {
+	Devices  []*FirmwareDevice
+	ByModel  map[string]*FirmwareSummary
+	BySite   map[string]*FirmwareSummary
+	Warnings []*WidgetWarnings
+}
+
+// GetWidgetWarnings returns the dashboard warnings widget for a single site.
+// Uses the legacy API endpoint: GET /api/s/{site}/stat/widget/warnings.
+func (u *Unifi) GetWidgetWarnings(site *Site) (*WidgetWarnings, error) {
+	if site == nil || site.Name == "" {
+		return nil, ErrNoSiteProvided
+	}
+
+	u.DebugLog("Polling Controller for widget warnings, site %s", site.SiteName)
+
+	path := fmt.Sprintf(APIWidgetWarningsPath, site.Name)
+
+	var response struct {
+		Data []WidgetWarnings `json:"data"`
+	}
+
+	if err := u.GetData(path, &response); err != nil {
+		return nil, fmt.Errorf("fetching widget warnings for site %s: %w", site.SiteName, err)
+	}
+
+	warnings := &WidgetWarnings{}
+	if len(response.Data) > 0 {
+		warnings = &response.Data[0]
+	}
+
+	warnings.SiteName = site.SiteName
+	warnings.SourceName = u.URL
+
+	return warnings, nil
+}
+
+// FirmwareReport fetches devices and the warnings widget for each site and
+// combines them into a per-model and per-site firmware view. See BuildFirmwareReport.
+func (u *Unifi) FirmwareReport(sites []*Site) (*FirmwareReport, error) {
+	devices, err := u.GetDevices(sites)
+	if err != nil {
+		return nil, err
+	}
+
+	warnings := make([]*WidgetWarnings, 0, len(sites))
+
+	for _, site := range sites {
+		w, err := u.GetWidgetWarnings(site)
+		if err != nil {
+			return nil, err
+		}
+
+		warnings = append(warnings, w)
+	}
+
+	return BuildFirmwareReport(devices, warnings), nil
+}
+
+// BuildFirmwareReport combines device firmware state with warnings widgets.
+// A device lags when another device of the same model runs a newer version, is EOL when
+// its model is flagged end of life or the site widget lists its MAC, and has an upgrade
+// pending when the controller marks it upgradable or names an upgrade target.
+// Devices are sorted by site, model and name.
+func BuildFirmwareReport(devices *Devices, warnings []*WidgetWarnings) *FirmwareReport {
+	report := &FirmwareReport{
+		Devices:  firmwareDevices(devices),
+		ByModel:  make(map[string]*FirmwareSummary),
+		BySite:   make(map[string]*FirmwareSummary),
+		Warnings: warnings,
+	}
+
+	eol := make(map[string]struct{})
+	pending := make(map[string]struct{})
+
+	for _, w := range warnings {
+		if w == nil {
+			continue
+		}
+
+		for _, mac := range w.EOLDevices {
+			eol[normalizeMAC(mac)] = struct{}{}
+		}
+
+		for _, mac := range w.UpgradableDevices {
+			pending[normalizeMAC(mac)] = struct{}{}
+		}
+	}
+
+	latest := make(map[string]string)
+
+	for _, d := range report.Devices {
+		if compareFirmwareVersions(d.Version, latest[d.Model]) > 0 {
+			latest[d.Model] = d.Version
+		}
+	}
+
+	for _, d := range report.Devices {
+		mac := normalizeMAC(d.Mac)
+		d.LatestVersion = latest[d.Model]
+		d.Lagging = compareFirmwareVersions(d.Version, d.LatestVersion) < 0
+
+		if d.UpgradeToFirmware != "" && compareFirmwareVersions(d.Version, d.UpgradeToFirmware) < 0 {
+			d.Lagging = true
+		}
+
+		if _, ok := eol[mac]; ok {
+			d.EOL = true
+		}
+
+		if _, ok := pending[mac]; ok {
+			d.UpgradePending = true
+		}
+
+		if d.UpgradeToFirmware != "" && d.UpgradeToFirmware != d.Version {
+			d.UpgradePending = true
+		}
+
+		report.ByModel[d.Model] = d.addTo(report.ByModel[d.Model])
+		report.BySite[d.SiteName] = d.addTo(report.BySite[d.SiteName])
+	}
+
+	sort.SliceStable(report.Devices, func(i, j int) bool {
+		a, b := report.Devices[i], report.Devices[j]
+		if a.SiteName != b.SiteName {
+			return a.SiteName < b.SiteName
+		}
+
+		if a.Model != b.Model {
+			return a.Model < b.Model
+		}
+
+		return a.Name < b.Name
+	})
+
+	return report
+}
+
+// addTo adds the device to a summary, creating it when nil.
+func (d *FirmwareDevice) addTo(s *FirmwareSummary) *FirmwareSummary {
+	if s == nil {
+		s = &FirmwareSummary{Versions: make(map[string]int)}
+	}
+
+	s.Devices++
+	s.Versions[d.Version]++
+
+	if d.Lagging {
+		s.Lagging++
+	}
+
+	if d.EOL {
+		s.EOL++
+	}
+
+	if d.UpgradePending {
+		s.UpgradePending++
+	}
+
+	return s
+}
+
+// firmwareDevices flattens every device type into FirmwareDevice records.
+func firmwareDevices(devices *Devices) []*FirmwareDevice {
+	list := make([]*FirmwareDevice, 0)
+	if devices == nil {
+		return list
+	}
+
+	add := func(site, mac, name, model, typ, version, upgradeTo string, upgradable, eol bool) {
+		list = append(list, &FirmwareDevice{
+			SiteName: site, Mac: mac, Name: name, Model: model, Type: typ, Version: version,
+			UpgradeToFirmware: upgradeTo, UpgradePending: upgradable, EOL: eol,
+		})
+	}
+
+	for _, d := range devices.UAPs {
+		add(d.SiteName, d.Mac, d.Name, d.Model, d.Type, d.Version, d.UpgradeToFirmware, d.Upgradable.Val, d.ModelInEOL.Val)
+	}
+
+	for _, d := range devices.USWs {
+		add(d.SiteName, d.Mac, d.Name, d.Model, d.Type, d.Version, d.UpgradeToFirmware, d.Upgradable.Val, d.ModelInEOL.Val)
+	}
+
+	for _, d := range devices.USGs {
+		add(d.SiteName, d.Mac, d.Name, d.Model, d.Type, d.Version, d.UpgradeToFirmware, d.Upgradable.Val, false)
+	}
+
+	for _, d := range devices.UDMs {
+		add(d.SiteName, d.Mac, d.Name, d.Model, d.Type, d.Version, d.UpgradeToFirmware, d.Upgradeable.Val, d.ModelInEOL.Val)
+	}
+
+	for _, d := range devices.UXGs {
+		add(d.SiteName, d.Mac, d.Name, d.Model, d.Type, d.Version, d.UpgradeToFirmware, d.Upgradable.Val, d.ModelInEol.Val)
+	}
+
+	for _, d := range devices.PDUs {
+		add(d.SiteName, d.Mac, d.Name, d.Model, d.Type, d.Version, d.UpgradeToFirmware, d.Upgradeable.Val, d.ModelInEOL.Val)
+	}
+
+	for _, d := range devices.UBBs {
+		add(d.SiteName, d.Mac, d.Name, d.Model, d.Type, d.Version, d.UpgradeToFirmware, d.Upgradable.Val, d.ModelInEol.Val)
+	}
+
+	for _, d := range devices.UCIs {
+		add(d.SiteName, d.Mac, d.Name, d.Model, d.Type, d.Version, d.UpgradeToFirmware, d.Upgradable.Val, d.ModelInEol.Val)
+	}
+
+	for _, d := range devices.UDBs {
+		add(d.SiteName, d.Mac, d.Name, d.Model, d.Type, d.Version, d.UpgradeToFirmware, d.Upgradable.Val, d.ModelInEOL.Val)
+	}
+
+	return list
+}
+
+// compareFirmwareVersions compares dotted firmware versions such as "6.6.55.15189"
+// segment by segment, numerically where both segments are numbers.
+// Returns -1, 0 or 1. An empty version sorts before any other.
+func compareFirmwareVersions(a, b string) int {
+	if a == "" || b == "" {
+		return strings.Compare(a, b)
+	}
+
+	as := strings.Split(strings.TrimLeft(a, "vV"), ".")
+	bs := strings.Split(strings.TrimLeft(b, "vV"), ".")
+
+	for i := 0; i < len(as) || i < len(bs); i++ {
+		if i >= len(as) {
+			return -1
+		}
+
+		if i >= len(bs) {
+			return 1
+		}
+
+		an, aErr := strconv.Atoi(as[i])
+		bn, bErr := strconv.Atoi(bs[i])
+
+		switch {
+		case aErr == nil && bErr == nil && an != bn:
+			if an < bn {
+				return -1
+			}
+
+			return 1
+		case aErr != nil || bErr != nil:
+			if c := strings.Compare(as[i], bs[i]); c != 0 {
+				return c
+			}
+		}
+	}
+
+	return 0
+}
@@ -0,0 +1,69 @@
+package unifi // nolint: testpackage
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCompareFirmwareVersions(t *testing.T) {
+	t.Parallel()
+
+	require.Equal(t, 0, compareFirmwareVersions("6.6.55.15189", "6.6.55.15189"))
+	require.Equal(t, -1, compareFirmwareVersions("6.6.55.15189", "6.6.65.15248"))
+	require.Equal(t, 1, compareFirmwareVersions("7.0.1", "6.10.9"))
+	require.Equal(t, -1, compareFirmwareVersions("4.0", "4.0.1"))
+	require.Equal(t, -1, compareFirmwareVersions("", "1.0"))
+	require.Equal(t, 1, compareFirmwareVersions("v2.1", "2.0"))
+}
+
+func TestBuildFirmwareReport(t *testing.T) {
+	t.Parallel()
+
+	devices := &Devices{
+		UAPs: []*UAP{
+			{SiteName: "hq", Mac: "aa:aa:aa:aa:aa:01", Name: "ap-1", Model: "U7PG2", Version: "6.6.55"},
+			{SiteName: "hq", Mac: "aa:aa:aa:aa:aa:02", Name: "ap-2", Model: "U7PG2", Version: "6.6.65"},
+			{SiteName: "branch", Mac: "aa:aa:aa:aa:aa:03", Name: "ap-3", Model: "U7PG2", Version: "6.6.65", Upgradable: *NewFlexBool(true)},
+		},
+		USWs: []*USW{
+			{SiteName: "hq", Mac: "bb:bb:bb:bb:bb:01", Name: "sw-1", Model: "US8", Version: "4.3.20", ModelInEOL: *NewFlexBool(true)},
+		},
+		UDMs: []*UDM{
+			{SiteName: "hq", Mac: "cc:cc:cc:cc:cc:01", Name: "gw", Model: "UDMPRO", Version: "4.0.6", UpgradeToFirmware: "4.1.13"},
+		},
+		UXGs: []*UXG{
+			{SiteName: "branch", Mac: "dd:dd:dd:dd:dd:01", Name: "uxg", Model: "UXGPRO", Version: "4.0.6", ModelInEol: *NewFlexBool(true)},
+		},
+	}
+	warnings := []*WidgetWarnings{
+		{SiteName: "branch", EOLDevices: []string{"AA-AA-AA-AA-AA-03"}},
+		nil,
+	}
+
+	report := BuildFirmwareReport(devices, warnings)
+	require.Len(t, report.Devices, 6)
+
+	byName := make(map[string]*FirmwareDevice)
+	for _, d := range report.Devices {
+		byName[d.Name] = d
+	}
+
+	require.True(t, byName["ap-1"].Lagging)
+	require.Equal(t, "6.6.65", byName["ap-1"].LatestVersion)
+	require.False(t, byName["ap-2"].Lagging)
+	require.True(t, byName["ap-3"].EOL)
+	require.True(t, byName["ap-3"].UpgradePending)
+	require.True(t, byName["sw-1"].EOL)
+	require.True(t, byName["gw"].UpgradePending)
+	require.True(t, byName["gw"].Lagging, "behind the offered upgrade")
+	require.True(t, byName["uxg"].EOL)
+
+	require.Equal(t, 3, report.ByModel["U7PG2"].Devices)
+	require.Equal(t, 1, report.ByModel["U7PG2"].Lagging)
+	require.Equal(t, 2, report.ByModel["U7PG2"].Versions["6.6.65"])
+	require.Equal(t, 4, report.BySite["hq"].Devices)
+	require.Equal(t, 1, report.BySite["hq"].EOL)
+	require.Equal(t, 1, report.BySite["hq"].UpgradePending)
+	require.Equal(t, "branch", report.Devices[0].SiteName)
+}
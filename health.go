@@ -0,0 +1,106 @@
+package unifi
+
+import "fmt"
+
+// Subsystem names reported in SubsystemHealth.Subsystem.
+const (
+	SubsystemWAN  = "wan"
+	SubsystemWWW  = "www"
+	SubsystemLAN  = "lan"
+	SubsystemWLAN = "wlan"
+	SubsystemVPN  = "vpn"
+)
+
+// SDNStatus represents the controller's cloud (SDN) connection state from /api/s/{site}/stat/sdn.
+type SDNStatus struct {
+	Connected       FlexBool `json:"connected"`
+	Enabled         FlexBool `json:"enabled"`
+	IsCloudKey      FlexBool `json:"is_cloud_key"`
+	IsUDM           FlexBool `json:"is_udm"`
+	IsUniFiOS       FlexBool `json:"is_unifi_os"`
+	LastConnected   FlexInt  `json:"last_connected,omitempty"`
+	OAuthEnabled    FlexBool `json:"oauth_enabled"`
+	SSOLoginEnabled FlexBool `json:"sso_login_enabled"`
+	UbicUUID        string   `json:"ubic_uuid,omitempty"`
+	URL             string   `json:"url,omitempty"`
+
+	SiteName   string `json:"-"`
+	SourceName string `json:"-"`
+}
+
+// GetSiteHealth returns the subsystem health summary for a single site.
+// This is the same data as Site.Health without fetching the whole site list.
+// Uses the legacy API endpoint: GET /api/s/{site}/stat/health.
+func (u *Unifi) GetSiteHealth(site *Site) ([]*SubsystemHealth, error) {
+	if site == nil || site.Name == "" {
+		return nil, ErrNoSiteProvided
+	}
+
+	u.DebugLog("Polling Controller for site health, site %s", site.SiteName)
+
+	path := fmt.Sprintf(APISiteHealthPath, site.Name)
+
+	var response struct {
+		Data []SubsystemHealth `json:"data"`
+	}
+
+	if err := u.GetData(path, &response); err != nil {
+		return nil, fmt.Errorf("fetching site health for site %s: %w", site.SiteName, err)
+	}
+
+	result := make([]*SubsystemHealth, len(response.Data))
+
+	for i := range response.Data {
+		response.Data[i].SiteName = site.SiteName
+		response.Data[i].SourceName = u.URL
+		result[i] = &response.Data[i]
+	}
+
+	return result, nil
+}
+
+// GetSDNStatus returns the controller's cloud connection state for a single site.
+// Uses the legacy API endpoint: GET /api/s/{site}/stat/sdn.
+func (u *Unifi) GetSDNStatus(site *Site) (*SDNStatus, error) {
+	if site == nil || site.Name == "" {
+		return nil, ErrNoSiteProvided
+	}
+
+	u.DebugLog("Polling Controller for SDN status, site %s", site.SiteName)
+
+	path := fmt.Sprintf(APISDNStatusPath, site.Name)
+
+	var response struct {
+		Data []SDNStatus `json:"data"`
+	}
+
+	if err := u.GetData(path, &response); err != nil {
+		return nil, fmt.Errorf("fetching SDN status for site %s: %w", site.SiteName, err)
+	}
+
+	status := &SDNStatus{}
+	if len(response.Data) > 0 {
+		status = &response.Data[0]
+	}
+
+	status.SiteName = site.SiteName
+	status.SourceName = u.URL
+
+	return status, nil
+}
+
+// OK reports whether the subsystem status is "ok".
+func (h *SubsystemHealth) OK() bool {
+	return h.Status == "ok"
+}
+
+// FindSubsystemHealth returns the entry for the named subsystem, or nil if it is not reported.
+func FindSubsystemHealth(health []*SubsystemHealth, subsystem string) *SubsystemHealth {
+	for _, h := range health {
+		if h != nil && h.Subsystem == subsystem {
+			return h
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,44 @@
+package unifi_test
+
+import (
+	"testing"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/stretchr/testify/require"
+	"github.com/unpoller/unifi/v5"
+)
+
+func TestSubsystemHealthStruct(t *testing.T) {
+	t.Parallel()
+
+	var h unifi.SubsystemHealth
+
+	err := gofakeit.Struct(&h)
+	require.NoError(t, err)
+	require.NotEmpty(t, h.GwMac)
+}
+
+func TestSDNStatusStruct(t *testing.T) {
+	t.Parallel()
+
+	var s unifi.SDNStatus
+
+	err := gofakeit.Struct(&s)
+	require.NoError(t, err)
+}
+
+func TestFindSubsystemHealth(t *testing.T) {
+	t.Parallel()
+
+	health := []*unifi.SubsystemHealth{
+		nil,
+		{Subsystem: unifi.SubsystemWAN, Status: "ok"},
+		{Subsystem: unifi.SubsystemWLAN, Status: "warning"},
+	}
+
+	wan := unifi.FindSubsystemHealth(health, unifi.SubsystemWAN)
+	require.NotNil(t, wan)
+	require.True(t, wan.OK())
+	require.False(t, unifi.FindSubsystemHealth(health, unifi.SubsystemWLAN).OK())
+	require.Nil(t, unifi.FindSubsystemHealth(health, unifi.SubsystemVPN))
+}
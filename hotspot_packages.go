@@ -0,0 +1,56 @@
+package unifi
+
+import "fmt"
+
+// HotspotPackage represents a paid hotspot access package from /api/s/{site}/stat/hotspotpackages.
+// Amount is in Currency units; rate limits are in Kbps and the quota in MB.
+type HotspotPackage struct {
+	ID                string   `fake:"{uuid}"          json:"_id"`
+	Amount            FlexInt  `json:"amount"`
+	ChargedAs         string   `json:"charged_as,omitempty"`
+	Currency          string   `fake:"{currencyshort}" json:"currency"`
+	Hours             FlexInt  `json:"hours"`
+	Index             FlexInt  `json:"index"`
+	LimitDown         FlexInt  `json:"limit_down,omitempty"`
+	LimitOverwrite    FlexBool `json:"limit_overwrite"`
+	LimitQuota        FlexInt  `json:"limit_quota,omitempty"`
+	LimitUp           FlexInt  `json:"limit_up,omitempty"`
+	Name              string   `fake:"{buzzword}"      json:"name"`
+	PaymentFieldsAddr FlexBool `json:"payment_fields_address_enabled"`
+	SiteID            string   `fake:"{uuid}"          json:"site_id"`
+	TrialDurationMin  FlexInt  `json:"trial_duration_minutes,omitempty"`
+	TrialReset        FlexInt  `json:"trial_reset,omitempty"`
+
+	SiteName   string `json:"-"`
+	SourceName string `json:"-"`
+}
+
+// GetHotspotPackages returns hotspot payment packages for a single site.
+// Uses the legacy API endpoint: GET /api/s/{site}/stat/hotspotpackages.
+func (u *Unifi) GetHotspotPackages(site *Site) ([]*HotspotPackage, error) {
+	if site == nil || site.Name == "" {
+		return nil, ErrNoSiteProvided
+	}
+
+	u.DebugLog("Polling Controller for hotspot packages, site %s", site.SiteName)
+
+	path := fmt.Sprintf(APIHotspotPackagesPath, site.Name)
+
+	var response struct {
+		Data []HotspotPackage `json:"data"`
+	}
+
+	if err := u.GetData(path, &response); err != nil {
+		return nil, fmt.Errorf("fetching hotspot packages for site %s: %w", site.SiteName, err)
+	}
+
+	result := make([]*HotspotPackage, len(response.Data))
+
+	for i := range response.Data {
+		response.Data[i].SiteName = site.SiteName
+		response.Data[i].SourceName = u.URL
+		result[i] = &response.Data[i]
+	}
+
+	return result, nil
+}
@@ -0,0 +1,19 @@
+package unifi_test
+
+import (
+	"testing"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/stretchr/testify/require"
+	"github.com/unpoller/unifi/v5"
+)
+
+func TestHotspotPackageStruct(t *testing.T) {
+	t.Parallel()
+
+	var p unifi.HotspotPackage
+
+	require.NoError(t, gofakeit.Struct(&p))
+	require.NotEmpty(t, p.ID)
+	require.NotEmpty(t, p.Currency)
+}
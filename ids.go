@@ -79,6 +79,10 @@ func (u *Unifi) GetIDSSite(site *Site, timeRange ...time.Time) ([]*IDS, error) {
 		return nil, ErrNoSiteProvided
 	}
 
+	if err := u.requireLegacyEvents(site, "stat/ips/event"); err != nil {
+		return nil, err
+	}
+
 	u.DebugLog("Polling Controller for IDS Events, site %s", site.SiteName)
 
 	var (
@@ -0,0 +1,47 @@
+package unifi_test
+
+import (
+	"testing"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/stretchr/testify/require"
+	"github.com/unpoller/unifi/v5"
+)
+
+func TestIntegrationClient(t *testing.T) {
+	t.Parallel()
+
+	var c unifi.IntegrationClient
+
+	require.NoError(t, gofakeit.Struct(&c))
+}
+
+func TestIntegrationClientToClient(t *testing.T) {
+	t.Parallel()
+
+	c := &unifi.IntegrationClient{
+		Access:      unifi.IntegrationClientAccess{Type: "GUEST"},
+		ConnectedAt: "2025-06-01T12:00:00Z",
+		ID:          "3a1b7c52-0b7e-4e0f-9a55-0f2c1c3a2b11",
+		IPAddress:   "192.168.1.50",
+		MacAddress:  "AA:BB:CC:DD:EE:FF",
+		Name:        "laptop",
+		Type:        "WIRELESS",
+		SiteName:    "Default",
+	}
+
+	client := c.ToClient()
+	require.Equal(t, "laptop", client.Name)
+	require.Equal(t, "aa:bb:cc:dd:ee:ff", client.Mac)
+	require.Equal(t, "192.168.1.50", client.IP)
+	require.False(t, client.IsWired.Val)
+	require.True(t, client.IsGuest.Val)
+	require.EqualValues(t, 1748779200, client.AssocTime.Val)
+	require.Equal(t, "Default", client.SiteName)
+	require.Empty(t, client.ID)
+
+	clients := unifi.IntegrationClientsToClients([]*unifi.IntegrationClient{c, nil, {Type: "WIRED", ConnectedAt: "bogus"}})
+	require.Len(t, clients, 2)
+	require.True(t, clients[1].IsWired.Val)
+	require.Zero(t, clients[1].AssocTime.Val)
+}
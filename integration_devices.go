@@ -3,8 +3,177 @@ package unifi
 import (
 	"encoding/json"
 	"fmt"
+	"slices"
 )
 
+// GetIntegrationDevices returns adopted devices for a site from the Integration/v1 API.
+// Requires Config.APIKey; returns ErrAPIKeyRequired when no key is configured.
+// Use JoinIntegrationDevices to attach the result to the legacy Devices from GetDevices.
+func (u *Unifi) GetIntegrationDevices(site *IntegrationSite) ([]*IntegrationDevice, error) {
+	if u == nil {
+		return nil, ErrNilUnifi
+	}
+
+	if site == nil {
+		return nil, ErrNoSiteProvided
+	}
+
+	if site.ID == "" {
+		return nil, fmt.Errorf("site %q has an empty ID; cannot construct Integration/v1 API path", site.Name)
+	}
+
+	u.DebugLog("Polling Integration/v1 for devices, site %s", site.Name)
+
+	path := fmt.Sprintf(APIIntegrationDevicesPath, site.ID)
+
+	items, err := getIntegrationList[IntegrationDevice](u, path)
+	if err != nil {
+		return nil, fmt.Errorf("fetching integration devices for site %s: %w", site.Name, err)
+	}
+
+	result := make([]*IntegrationDevice, len(items))
+
+	for i := range items {
+		items[i].SiteName = site.Name
+		result[i] = &items[i]
+	}
+
+	return result, nil
+}
+
+// GetIntegrationDevice returns model, firmware, uplink and interface details for a
+// single device from the Integration/v1 API.
+func (u *Unifi) GetIntegrationDevice(site *IntegrationSite, deviceID string) (*IntegrationDeviceDetail, error) {
+	if u == nil {
+		return nil, ErrNilUnifi
+	}
+
+	if u.APIKey == "" {
+		return nil, ErrAPIKeyRequired
+	}
+
+	if site == nil {
+		return nil, ErrNoSiteProvided
+	}
+
+	if site.ID == "" {
+		return nil, fmt.Errorf("site %q has an empty ID; cannot construct Integration/v1 API path", site.Name)
+	}
+
+	if deviceID == "" {
+		return nil, fmt.Errorf("deviceID must not be empty")
+	}
+
+	u.DebugLog("Polling Integration/v1 for device, site %s device %s", site.Name, deviceID)
+
+	body, err := u.GetJSON(fmt.Sprintf(APIIntegrationDevicePath, site.ID, deviceID))
+	if err != nil {
+		return nil, fmt.Errorf("fetching device for site %s device %s: %w", site.Name, deviceID, err)
+	}
+
+	var device IntegrationDeviceDetail
+
+	if err := json.Unmarshal(body, &device); err != nil {
+		return nil, fmt.Errorf("parsing device for site %s device %s: %w", site.Name, deviceID, err)
+	}
+
+	device.SiteName = site.Name
+
+	return &device, nil
+}
+
+// HasFeature reports whether the device lists the named feature, e.g. "switching".
+func (d *IntegrationDevice) HasFeature(name string) bool {
+	return slices.Contains(d.Features, name)
+}
+
+// HasFeature reports whether the device lists the named feature, e.g. "accessPoint".
+func (d *IntegrationDeviceDetail) HasFeature(name string) bool {
+	_, ok := d.Features[name]
+
+	return ok
+}
+
+// JoinIntegrationDevices matches Integration/v1 devices to legacy devices by MAC and
+// stores the matches in devices.Integration, keyed by normalized MAC. Returns the
+// number of legacy devices that found a match.
+func JoinIntegrationDevices(devices *Devices, items []*IntegrationDevice) int {
+	if devices == nil {
+		return 0
+	}
+
+	byMAC := make(map[string]*IntegrationDevice, len(items))
+
+	for _, item := range items {
+		if item != nil && item.MacAddress != "" {
+			byMAC[normalizeMAC(item.MacAddress)] = item
+		}
+	}
+
+	devices.Integration = make(map[string]*IntegrationDevice)
+
+	for _, mac := range devices.macs() {
+		if item, ok := byMAC[mac]; ok {
+			devices.Integration[mac] = item
+		}
+	}
+
+	return len(devices.Integration)
+}
+
+// IntegrationDevice returns the Integration/v1 device joined to the legacy device
+// with the given MAC, or nil when JoinIntegrationDevices found no match.
+func (d *Devices) IntegrationDevice(mac string) *IntegrationDevice {
+	if d == nil {
+		return nil
+	}
+
+	return d.Integration[normalizeMAC(mac)]
+}
+
+// macs returns the normalized MAC of every legacy device.
+func (d *Devices) macs() []string {
+	macs := make([]string, 0)
+
+	for _, v := range d.UAPs {
+		macs = append(macs, normalizeMAC(v.Mac))
+	}
+
+	for _, v := range d.USGs {
+		macs = append(macs, normalizeMAC(v.Mac))
+	}
+
+	for _, v := range d.USWs {
+		macs = append(macs, normalizeMAC(v.Mac))
+	}
+
+	for _, v := range d.UDMs {
+		macs = append(macs, normalizeMAC(v.Mac))
+	}
+
+	for _, v := range d.UXGs {
+		macs = append(macs, normalizeMAC(v.Mac))
+	}
+
+	for _, v := range d.PDUs {
+		macs = append(macs, normalizeMAC(v.Mac))
+	}
+
+	for _, v := range d.UBBs {
+		macs = append(macs, normalizeMAC(v.Mac))
+	}
+
+	for _, v := range d.UCIs {
+		macs = append(macs, normalizeMAC(v.Mac))
+	}
+
+	for _, v := range d.UDBs {
+		macs = append(macs, normalizeMAC(v.Mac))
+	}
+
+	return macs
+}
+
 // GetIntegrationDeviceStats returns statistics for a single device from the Integration/v1 API.
 func (u *Unifi) GetIntegrationDeviceStats(site *IntegrationSite, deviceID string) (*IntegrationDeviceStats, error) {
 	if u == nil {
@@ -31,3 +31,41 @@ func TestIntegrationDeviceUplinkStats(t *testing.T) {
 
 	require.NoError(t, gofakeit.Struct(&s))
 }
+
+func TestIntegrationDevice(t *testing.T) {
+	t.Parallel()
+
+	var d unifi.IntegrationDevice
+
+	require.NoError(t, gofakeit.Struct(&d))
+}
+
+func TestIntegrationDeviceDetail(t *testing.T) {
+	t.Parallel()
+
+	var d unifi.IntegrationDeviceDetail
+
+	require.NoError(t, gofakeit.Struct(&d))
+}
+
+func TestJoinIntegrationDevices(t *testing.T) {
+	t.Parallel()
+
+	devices := &unifi.Devices{
+		UAPs: []*unifi.UAP{{Mac: "aa:bb:cc:00:00:01"}},
+		USWs: []*unifi.USW{{Mac: "aa:bb:cc:00:00:02"}, {Mac: "aa:bb:cc:00:00:03"}},
+	}
+
+	ap := &unifi.IntegrationDevice{ID: "ap", MacAddress: "AA:BB:CC:00:00:01", Features: []string{"accessPoint"}}
+	sw := &unifi.IntegrationDevice{ID: "sw", MacAddress: "aa:bb:cc:00:00:02", Features: []string{"switching"}}
+	other := &unifi.IntegrationDevice{ID: "other", MacAddress: "aa:bb:cc:00:00:09"}
+
+	require.Equal(t, 2, unifi.JoinIntegrationDevices(devices, []*unifi.IntegrationDevice{ap, sw, other, nil}))
+	require.Same(t, ap, devices.IntegrationDevice("aa:bb:cc:00:00:01"))
+	require.Same(t, sw, devices.IntegrationDevice("AA-BB-CC-00-00-02"))
+	require.Nil(t, devices.IntegrationDevice("aa:bb:cc:00:00:03"))
+	require.Nil(t, devices.IntegrationDevice("aa:bb:cc:00:00:09"))
+	require.True(t, ap.HasFeature("accessPoint"))
+	require.False(t, sw.HasFeature("accessPoint"))
+	require.Zero(t, unifi.JoinIntegrationDevices(nil, []*unifi.IntegrationDevice{ap}))
+}
@@ -0,0 +1,240 @@
+package unifi
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/netip"
+	"strings"
+)
+
+// LANEnrichedConfiguration represents a LAN network configuration with statistics.
+// It is the LAN counterpart of WANEnrichedConfiguration.
+type LANEnrichedConfiguration struct {
+	Configuration Network       `json:"configuration"`
+	Statistics    LANStatistics `json:"statistics"`
+
+	SiteName string `json:"-"`
+}
+
+// LANStatistics represents per-LAN usage statistics.
+type LANStatistics struct {
+	ClientCount      FlexInt `json:"num_sta"`
+	DHCPActiveLeases FlexInt `json:"dhcp_active_leases"`
+	DHCPPoolSize     FlexInt `json:"dhcp_max_leases"`
+	RxBytes          FlexInt `json:"rx_bytes"`
+	TxBytes          FlexInt `json:"tx_bytes"`
+}
+
+// MDNSConfiguration represents the gateway mDNS reflector settings from /v2/api/site/{site}/lan/mdns.
+// NetworkIDs lists the networks mDNS is reflected between when Mode is "custom".
+type MDNSConfiguration struct {
+	Enabled    FlexBool `json:"enabled"`
+	Mode       string   `json:"mode"` // auto, all, custom
+	NetworkIDs []string `json:"network_ids"`
+	Services   []string `json:"services"` // reflected service types, e.g. _airplay._tcp
+
+	SiteName string `json:"-"`
+}
+
+// GlobalNetworkConfig represents site-wide network settings from /v2/api/site/{site}/global/config/network.
+type GlobalNetworkConfig struct {
+	DHCPSnooping       FlexBool `json:"dhcp_snooping"`
+	IGMPSnooping       FlexBool `json:"igmp_snooping"`
+	IPv6Enabled        FlexBool `json:"ipv6_enabled"`
+	MulticastDNS       FlexBool `json:"mdns_enabled"`
+	NetworkIsolation   FlexBool `json:"network_isolation"`
+	UPnPEnabled        FlexBool `json:"upnp_enabled"`
+	UPnPNATPMPEnabled  FlexBool `json:"upnp_nat_pmp_enabled"`
+	UPnPSecureMode     FlexBool `json:"upnp_secure_mode"`
+	UPnPWANInterface   string   `json:"upnp_wan_interface"`
+	WANDNSPreferredIPs []string `json:"wan_dns_preferred_ips,omitempty"`
+
+	SiteName string `json:"-"`
+}
+
+// ExcludedIPRange represents an address or range excluded from a network's DHCP pool,
+// from /v2/api/site/{site}/excluded-ips/. Stop is empty for a single address.
+// NetworkID references Network.ID.
+type ExcludedIPRange struct {
+	ID          string `fake:"{uuid}"        json:"_id"`
+	Description string `json:"description,omitempty"`
+	NetworkID   string `fake:"{uuid}"        json:"network_id"`
+	Start       string `fake:"{ipv4address}" json:"start"`
+	Stop        string `json:"stop,omitempty"`
+
+	SiteName string `json:"-"`
+}
+
+// GetLANEnrichedConfiguration returns enriched LAN configuration for all LAN networks.
+// The API returns a top-level array [{...}, {...}], not {"data": [...]}.
+func (u *Unifi) GetLANEnrichedConfiguration(sites []*Site) ([]*LANEnrichedConfiguration, error) {
+	if u == nil {
+		return nil, ErrNilUnifi
+	}
+
+	data := []*LANEnrichedConfiguration{}
+
+	for _, site := range sites {
+		if site == nil || site.Name == "" {
+			return nil, ErrNoSiteProvided
+		}
+
+		path := fmt.Sprintf(APILANEnrichedConfigPath, site.Name)
+
+		u.DebugLog("Fetching LAN enriched configuration for site %s", site.Name)
+
+		body, err := u.GetJSON(path)
+		if err != nil {
+			return nil, err
+		}
+
+		var raw []*LANEnrichedConfiguration
+		if err := json.Unmarshal(body, &raw); err != nil {
+			return nil, err
+		}
+
+		for _, lan := range raw {
+			if lan != nil {
+				lan.SiteName = site.SiteName
+				data = append(data, lan)
+			}
+		}
+	}
+
+	return data, nil
+}
+
+// GetMDNSConfiguration returns the mDNS reflector settings for a single site.
+// Uses the v2 API endpoint: GET /proxy/network/v2/api/site/{site}/lan/mdns
+func (u *Unifi) GetMDNSConfiguration(site *Site) (*MDNSConfiguration, error) {
+	if site == nil || site.Name == "" {
+		return nil, ErrNoSiteProvided
+	}
+
+	u.DebugLog("Polling Controller for mDNS configuration, site %s", site.SiteName)
+
+	body, err := u.GetJSON(fmt.Sprintf(APILANMDNSPath, site.Name))
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch mDNS configuration for site %s: %w", site.SiteName, err)
+	}
+
+	var config MDNSConfiguration
+	if err := json.Unmarshal(body, &config); err != nil {
+		return nil, fmt.Errorf("failed to parse mDNS configuration for site %s: %w", site.SiteName, err)
+	}
+
+	config.SiteName = site.SiteName
+
+	return &config, nil
+}
+
+// GetGlobalNetworkConfig returns site-wide network settings for a single site.
+// Uses the v2 API endpoint: GET /proxy/network/v2/api/site/{site}/global/config/network
+func (u *Unifi) GetGlobalNetworkConfig(site *Site) (*GlobalNetworkConfig, error) {
+	if site == nil || site.Name == "" {
+		return nil, ErrNoSiteProvided
+	}
+
+	u.DebugLog("Polling Controller for global network config, site %s", site.SiteName)
+
+	body, err := u.GetJSON(fmt.Sprintf(APIGlobalNetworkConfigPath, site.Name))
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch global network config for site %s: %w", site.SiteName, err)
+	}
+
+	var config GlobalNetworkConfig
+	if err := json.Unmarshal(body, &config); err != nil {
+		return nil, fmt.Errorf("failed to parse global network config for site %s: %w", site.SiteName, err)
+	}
+
+	config.SiteName = site.SiteName
+
+	return &config, nil
+}
+
+// GetExcludedIPs returns the addresses and ranges excluded from DHCP pools on a single site.
+// Pass the result to ApplyExcludedIPs so DHCP pool statistics account for them.
+// Uses the v2 API endpoint: GET /proxy/network/v2/api/site/{site}/excluded-ips/
+func (u *Unifi) GetExcludedIPs(site *Site) ([]*ExcludedIPRange, error) {
+	if site == nil || site.Name == "" {
+		return nil, ErrNoSiteProvided
+	}
+
+	u.DebugLog("Polling Controller for excluded IPs, site %s", site.SiteName)
+
+	body, err := u.GetJSON(fmt.Sprintf(APIExcludedIPsPath, site.Name))
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch excluded IPs for site %s: %w", site.SiteName, err)
+	}
+
+	var raw []*ExcludedIPRange
+	if err := json.Unmarshal(body, &raw); err != nil {
+		return nil, fmt.Errorf("failed to parse excluded IPs for site %s: %w", site.SiteName, err)
+	}
+
+	ranges := make([]*ExcludedIPRange, 0, len(raw))
+
+	for _, r := range raw {
+		if r == nil {
+			continue
+		}
+
+		r.SiteName = site.SiteName
+		ranges = append(ranges, r)
+	}
+
+	return ranges, nil
+}
+
+// ApplyExcludedIPs attaches excluded ranges to each lease on the same network, matched by
+// DHCPLease.NetworkID or the ID of its NetworkTableEntry. GetAvailableIPs and
+// GetUtilizationPercentage then leave excluded addresses inside the pool out of the count.
+func ApplyExcludedIPs(leases []*DHCPLease, excluded []*ExcludedIPRange) {
+	byNetwork := make(map[string][]*ExcludedIPRange)
+
+	for _, e := range excluded {
+		if e != nil {
+			byNetwork[e.NetworkID] = append(byNetwork[e.NetworkID], e)
+		}
+	}
+
+	for _, l := range leases {
+		if l == nil {
+			continue
+		}
+
+		networkID := l.NetworkID
+		if networkID == "" && l.NetworkTableEntry != nil {
+			networkID = l.NetworkTableEntry.ID
+		}
+
+		l.ExcludedIPs = byNetwork[networkID]
+	}
+}
+
+// clip returns the part of the range that falls within start-stop (inclusive).
+// ok is false when the range is invalid or does not overlap the pool.
+func (e *ExcludedIPRange) clip(start, stop netip.Addr) (from, to netip.Addr, ok bool) {
+	from, err := netip.ParseAddr(strings.TrimSpace(e.Start))
+	if err != nil {
+		return from, to, false
+	}
+
+	to = from
+
+	if e.Stop != "" {
+		if to, err = netip.ParseAddr(strings.TrimSpace(e.Stop)); err != nil {
+			return from, to, false
+		}
+	}
+
+	if from.Compare(start) < 0 {
+		from = start
+	}
+
+	if to.Compare(stop) > 0 {
+		to = stop
+	}
+
+	return from, to, from.Compare(to) <= 0
+}
@@ -0,0 +1,62 @@
+package unifi_test
+
+import (
+	"testing"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/stretchr/testify/require"
+	"github.com/unpoller/unifi/v5"
+)
+
+func TestLANEnrichedConfigurationStruct(t *testing.T) {
+	t.Parallel()
+
+	var l unifi.LANEnrichedConfiguration
+
+	err := gofakeit.Struct(&l)
+	require.NoError(t, err)
+	require.NotEmpty(t, l.Configuration.ID)
+}
+
+func TestExcludedIPRangeStruct(t *testing.T) {
+	t.Parallel()
+
+	var e unifi.ExcludedIPRange
+
+	err := gofakeit.Struct(&e)
+	require.NoError(t, err)
+	require.NotEmpty(t, e.Start)
+}
+
+func TestApplyExcludedIPs(t *testing.T) {
+	t.Parallel()
+
+	pool := &unifi.NetworkTableEntry{
+		ID:                   "lan",
+		DhcpdEnabled:         *unifi.NewFlexBool(true),
+		DhcpdStart:           "192.168.1.100",
+		DhcpdStop:            "192.168.1.199",
+		ActiveDhcpLeaseCount: *unifi.NewFlexInt(40),
+	}
+	lease := &unifi.DHCPLease{NetworkTableEntry: pool}
+	other := &unifi.DHCPLease{NetworkID: "guest", NetworkTableEntry: pool}
+
+	require.Equal(t, 60, lease.GetAvailableIPs())
+
+	unifi.ApplyExcludedIPs([]*unifi.DHCPLease{lease, other, nil}, []*unifi.ExcludedIPRange{
+		{NetworkID: "lan", Start: "192.168.1.150", Stop: "192.168.1.159"}, // 10 inside the pool
+		{NetworkID: "lan", Start: "192.168.1.195", Stop: "192.168.1.210"}, // 5 inside the pool
+		{NetworkID: "lan", Start: "192.168.1.120"},                        // single address
+		{NetworkID: "lan", Start: "192.168.1.10", Stop: "192.168.1.20"},   // outside the pool
+		{NetworkID: "lan", Start: "192.168.1.155", Stop: "192.168.1.160"}, // 1 new, 5 already excluded
+		nil,
+	})
+
+	require.Len(t, lease.ExcludedIPs, 5)
+	require.Empty(t, other.ExcludedIPs)
+	require.Equal(t, 17, lease.GetExcludedIPCount())
+	require.Equal(t, 83, lease.GetUsablePoolSize())
+	require.Equal(t, 43, lease.GetAvailableIPs())
+	require.InDelta(t, 40.0/83.0*100, lease.GetUtilizationPercentage(), 0.001)
+	require.Equal(t, 60, other.GetAvailableIPs())
+}
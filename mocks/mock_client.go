@@ -474,6 +474,24 @@ func (m *MockUnifi) GetWANEnrichedConfiguration(_ []*unifi.Site) ([]*unifi.WANEn
 	return results, nil
 }
 
+// GetLANEnrichedConfiguration returns enriched LAN configuration for all LAN networks.
+func (m *MockUnifi) GetLANEnrichedConfiguration(_ []*unifi.Site) ([]*unifi.LANEnrichedConfiguration, error) {
+	results := make([]*unifi.LANEnrichedConfiguration, numItemsMocked)
+
+	for i := 0; i < numItemsMocked; i++ {
+		var a unifi.LANEnrichedConfiguration
+
+		err := gofakeit.Struct(&a)
+		if err != nil {
+			return results, err
+		}
+
+		results[i] = &a
+	}
+
+	return results, nil
+}
+
 // GetWANLoadBalancingStatus returns the current load balancing status for WAN interfaces.
 func (m *MockUnifi) GetWANLoadBalancingStatus(_ []*unifi.Site) (*unifi.WANLoadBalancingStatus, error) {
 	var w unifi.WANLoadBalancingStatus
@@ -677,6 +695,24 @@ func (m *MockUnifi) GetCountryTraffic(_ []*unifi.Site, _ *unifi.EpochMillisTimeP
 	return results, nil
 }
 
+// GetAppTrafficRate returns per-application throughput time series.
+func (m *MockUnifi) GetAppTrafficRate(_ *unifi.Site, _ *unifi.EpochMillisTimePeriod, _ []int, _ []string) ([]*unifi.AppTrafficRate, error) {
+	results := make([]*unifi.AppTrafficRate, numItemsMocked)
+
+	for i := 0; i < numItemsMocked; i++ {
+		var a unifi.AppTrafficRate
+
+		err := gofakeit.Struct(&a)
+		if err != nil {
+			return results, err
+		}
+
+		results[i] = &a
+	}
+
+	return results, nil
+}
+
 // GetProtectLogs returns Protect system log events.
 func (m *MockUnifi) GetProtectLogs(_ *unifi.ProtectLogRequest) ([]*unifi.ProtectLogEntry, error) {
 	results := make([]*unifi.ProtectLogEntry, numItemsMocked)
@@ -845,6 +881,36 @@ func (m *MockUnifi) GetAllIntegrationDeviceStats(_ *unifi.IntegrationSite) ([]*u
 	return results, nil
 }
 
+// GetIntegrationDevices returns adopted devices for a site.
+func (m *MockUnifi) GetIntegrationDevices(_ *unifi.IntegrationSite) ([]*unifi.IntegrationDevice, error) {
+	results := make([]*unifi.IntegrationDevice, numItemsMocked)
+
+	for i := 0; i < numItemsMocked; i++ {
+		var a unifi.IntegrationDevice
+
+		err := gofakeit.Struct(&a)
+		if err != nil {
+			return results, err
+		}
+
+		results[i] = &a
+	}
+
+	return results, nil
+}
+
+// GetIntegrationDevice returns details for a single device.
+func (m *MockUnifi) GetIntegrationDevice(_ *unifi.IntegrationSite, _ string) (*unifi.IntegrationDeviceDetail, error) {
+	var a unifi.IntegrationDeviceDetail
+
+	err := gofakeit.Struct(&a)
+	if err != nil {
+		return nil, err
+	}
+
+	return &a, nil
+}
+
 // GetWifiBroadcasts returns WiFi broadcast configurations for a site.
 func (m *MockUnifi) GetWifiBroadcasts(_ *unifi.IntegrationSite) ([]*unifi.WifiBroadcast, error) {
 	results := make([]*unifi.WifiBroadcast, numItemsMocked)
@@ -935,6 +1001,36 @@ func (m *MockUnifi) GetIntegrationWANs(_ *unifi.IntegrationSite) ([]*unifi.Integ
 	return results, nil
 }
 
+// GetIntegrationClients returns connected clients for a site.
+func (m *MockUnifi) GetIntegrationClients(_ *unifi.IntegrationSite) ([]*unifi.IntegrationClient, error) {
+	results := make([]*unifi.IntegrationClient, numItemsMocked)
+
+	for i := 0; i < numItemsMocked; i++ {
+		var a unifi.IntegrationClient
+
+		err := gofakeit.Struct(&a)
+		if err != nil {
+			return results, err
+		}
+
+		results[i] = &a
+	}
+
+	return results, nil
+}
+
+// GetIntegrationClient returns a single connected client.
+func (m *MockUnifi) GetIntegrationClient(_ *unifi.IntegrationSite, _ string) (*unifi.IntegrationClient, error) {
+	var a unifi.IntegrationClient
+
+	err := gofakeit.Struct(&a)
+	if err != nil {
+		return nil, err
+	}
+
+	return &a, nil
+}
+
 // GetVPNServers returns VPN server configurations for a site.
 func (m *MockUnifi) GetVPNServers(_ *unifi.IntegrationSite) ([]*unifi.VPNServer, error) {
 	results := make([]*unifi.VPNServer, numItemsMocked)
@@ -1229,6 +1325,903 @@ func (m *MockUnifi) GetSSLCertificate(_ *unifi.Site) (*unifi.SSLCertificate, err
 	return &a, nil
 }
 
+// GetFirewallGroups returns legacy firewall groups for a site.
+func (m *MockUnifi) GetFirewallGroups(_ *unifi.Site) ([]*unifi.FirewallGroup, error) {
+	results := make([]*unifi.FirewallGroup, numItemsMocked)
+
+	for i := 0; i < numItemsMocked; i++ {
+		var a unifi.FirewallGroup
+
+		err := gofakeit.Struct(&a)
+		if err != nil {
+			return results, err
+		}
+
+		results[i] = &a
+	}
+
+	return results, nil
+}
+
+// GetFirewallRules returns legacy firewall rules for a site.
+func (m *MockUnifi) GetFirewallRules(_ *unifi.Site) ([]*unifi.FirewallRule, error) {
+	results := make([]*unifi.FirewallRule, numItemsMocked)
+
+	for i := 0; i < numItemsMocked; i++ {
+		var a unifi.FirewallRule
+
+		err := gofakeit.Struct(&a)
+		if err != nil {
+			return results, err
+		}
+
+		results[i] = &a
+	}
+
+	return results, nil
+}
+
+// GetStaticRoutes returns user-defined static routes for a site.
+func (m *MockUnifi) GetStaticRoutes(_ *unifi.Site) ([]*unifi.StaticRoute, error) {
+	results := make([]*unifi.StaticRoute, numItemsMocked)
+
+	for i := 0; i < numItemsMocked; i++ {
+		var a unifi.StaticRoute
+
+		err := gofakeit.Struct(&a)
+		if err != nil {
+			return results, err
+		}
+
+		results[i] = &a
+	}
+
+	return results, nil
+}
+
+// GetTrafficRoutes returns policy-based traffic routes for a site.
+func (m *MockUnifi) GetTrafficRoutes(_ *unifi.Site) ([]*unifi.TrafficRoute, error) {
+	results := make([]*unifi.TrafficRoute, numItemsMocked)
+
+	for i := 0; i < numItemsMocked; i++ {
+		var a unifi.TrafficRoute
+
+		err := gofakeit.Struct(&a)
+		if err != nil {
+			return results, err
+		}
+
+		results[i] = &a
+	}
+
+	return results, nil
+}
+
+// GetTrafficRules returns traffic rules for a site.
+func (m *MockUnifi) GetTrafficRules(_ *unifi.Site) ([]*unifi.TrafficRule, error) {
+	results := make([]*unifi.TrafficRule, numItemsMocked)
+
+	for i := 0; i < numItemsMocked; i++ {
+		var a unifi.TrafficRule
+
+		err := gofakeit.Struct(&a)
+		if err != nil {
+			return results, err
+		}
+
+		results[i] = &a
+	}
+
+	return results, nil
+}
+
+// GetBGPConfig returns BGP configurations for a site.
+func (m *MockUnifi) GetBGPConfig(_ *unifi.Site) ([]*unifi.BGPConfig, error) {
+	results := make([]*unifi.BGPConfig, numItemsMocked)
+
+	for i := 0; i < numItemsMocked; i++ {
+		var a unifi.BGPConfig
+
+		err := gofakeit.Struct(&a)
+		if err != nil {
+			return results, err
+		}
+
+		results[i] = &a
+	}
+
+	return results, nil
+}
+
+// GetOSPFRouters returns OSPF router configurations for a site.
+func (m *MockUnifi) GetOSPFRouters(_ *unifi.Site) ([]*unifi.OSPFRouter, error) {
+	results := make([]*unifi.OSPFRouter, numItemsMocked)
+
+	for i := 0; i < numItemsMocked; i++ {
+		var a unifi.OSPFRouter
+
+		err := gofakeit.Struct(&a)
+		if err != nil {
+			return results, err
+		}
+
+		results[i] = &a
+	}
+
+	return results, nil
+}
+
+// GetPortForwardStats returns a mocked list of port forward stats
+func (m *MockUnifi) GetPortForwardStats(_ *unifi.Site) ([]*unifi.PortForwardStats, error) {
+	results := make([]*unifi.PortForwardStats, numItemsMocked)
+
+	for i := 0; i < numItemsMocked; i++ {
+		var a unifi.PortForwardStats
+
+		err := gofakeit.Struct(&a)
+		if err != nil {
+			return results, err
+		}
+
+		results[i] = &a
+	}
+
+	return results, nil
+}
+
+// GetNATRules returns a mocked list of NAT rules
+func (m *MockUnifi) GetNATRules(_ *unifi.Site) ([]*unifi.NATRule, error) {
+	results := make([]*unifi.NATRule, numItemsMocked)
+
+	for i := 0; i < numItemsMocked; i++ {
+		var a unifi.NATRule
+
+		err := gofakeit.Struct(&a)
+		if err != nil {
+			return results, err
+		}
+
+		results[i] = &a
+	}
+
+	return results, nil
+}
+
+// GetVPNConnections returns a mocked list of VPN connections
+func (m *MockUnifi) GetVPNConnections(_ *unifi.Site) ([]*unifi.VPNConnection, error) {
+	results := make([]*unifi.VPNConnection, numItemsMocked)
+
+	for i := 0; i < numItemsMocked; i++ {
+		var a unifi.VPNConnection
+
+		err := gofakeit.Struct(&a)
+		if err != nil {
+			return results, err
+		}
+
+		results[i] = &a
+	}
+
+	return results, nil
+}
+
+// GetWireGuardUsers returns a mocked list of WireGuard users
+func (m *MockUnifi) GetWireGuardUsers(_ *unifi.Site) ([]*unifi.WireGuardUser, error) {
+	results := make([]*unifi.WireGuardUser, numItemsMocked)
+
+	for i := 0; i < numItemsMocked; i++ {
+		var a unifi.WireGuardUser
+
+		err := gofakeit.Struct(&a)
+		if err != nil {
+			return results, err
+		}
+
+		results[i] = &a
+	}
+
+	return results, nil
+}
+
+// GetUserGroups returns a mocked list of user groups
+func (m *MockUnifi) GetUserGroups(_ *unifi.Site) ([]*unifi.UserGroup, error) {
+	results := make([]*unifi.UserGroup, numItemsMocked)
+
+	for i := 0; i < numItemsMocked; i++ {
+		var a unifi.UserGroup
+
+		err := gofakeit.Struct(&a)
+		if err != nil {
+			return results, err
+		}
+
+		results[i] = &a
+	}
+
+	return results, nil
+}
+
+// GetQoSRules returns a mocked list of QoS rules
+func (m *MockUnifi) GetQoSRules(_ *unifi.Site) ([]*unifi.QoSRule, error) {
+	results := make([]*unifi.QoSRule, numItemsMocked)
+
+	for i := 0; i < numItemsMocked; i++ {
+		var a unifi.QoSRule
+
+		err := gofakeit.Struct(&a)
+		if err != nil {
+			return results, err
+		}
+
+		results[i] = &a
+	}
+
+	return results, nil
+}
+
+// GetContentFilteringProfiles returns a mocked list of content filtering profiles
+func (m *MockUnifi) GetContentFilteringProfiles(_ *unifi.Site) ([]*unifi.ContentFilteringProfile, error) {
+	results := make([]*unifi.ContentFilteringProfile, numItemsMocked)
+
+	for i := 0; i < numItemsMocked; i++ {
+		var a unifi.ContentFilteringProfile
+
+		err := gofakeit.Struct(&a)
+		if err != nil {
+			return results, err
+		}
+
+		results[i] = &a
+	}
+
+	return results, nil
+}
+
+// GetContentFilteringCategories returns a mocked list of content filtering categories
+func (m *MockUnifi) GetContentFilteringCategories(_ *unifi.Site) ([]*unifi.ContentFilteringCategory, error) {
+	results := make([]*unifi.ContentFilteringCategory, numItemsMocked)
+
+	for i := 0; i < numItemsMocked; i++ {
+		var a unifi.ContentFilteringCategory
+
+		err := gofakeit.Struct(&a)
+		if err != nil {
+			return results, err
+		}
+
+		results[i] = &a
+	}
+
+	return results, nil
+}
+
+// GetSSLInspectionSetting returns a mocked SSL inspection setting
+func (m *MockUnifi) GetSSLInspectionSetting(_ *unifi.Site) (*unifi.SSLInspectionSetting, error) {
+	var a unifi.SSLInspectionSetting
+
+	err := gofakeit.Struct(&a)
+	if err != nil {
+		return nil, err
+	}
+
+	return &a, nil
+}
+
+// GetSSLInspectionCertificates returns a mocked list of SSL inspection certificates
+func (m *MockUnifi) GetSSLInspectionCertificates(_ *unifi.Site) ([]*unifi.SSLCertificate, error) {
+	results := make([]*unifi.SSLCertificate, numItemsMocked)
+
+	for i := 0; i < numItemsMocked; i++ {
+		var a unifi.SSLCertificate
+
+		err := gofakeit.Struct(&a)
+		if err != nil {
+			return results, err
+		}
+
+		results[i] = &a
+	}
+
+	return results, nil
+}
+
+// GetActiveSSLInspectionCertificate returns a mocked active SSL inspection certificate
+func (m *MockUnifi) GetActiveSSLInspectionCertificate(_ *unifi.Site) (*unifi.SSLCertificate, error) {
+	var a unifi.SSLCertificate
+
+	err := gofakeit.Struct(&a)
+	if err != nil {
+		return nil, err
+	}
+
+	return &a, nil
+}
+
+// GetStaticDNSRecords returns a mocked list of static DNS records
+func (m *MockUnifi) GetStaticDNSRecords(_ *unifi.Site) ([]*unifi.StaticDNSRecord, error) {
+	results := make([]*unifi.StaticDNSRecord, numItemsMocked)
+
+	for i := 0; i < numItemsMocked; i++ {
+		var a unifi.StaticDNSRecord
+
+		err := gofakeit.Struct(&a)
+		if err != nil {
+			return results, err
+		}
+
+		results[i] = &a
+	}
+
+	return results, nil
+}
+
+// GetStaticDNSDeviceRecords returns a mocked list of device DNS records
+func (m *MockUnifi) GetStaticDNSDeviceRecords(_ *unifi.Site) ([]*unifi.StaticDNSRecord, error) {
+	results := make([]*unifi.StaticDNSRecord, numItemsMocked)
+
+	for i := 0; i < numItemsMocked; i++ {
+		var a unifi.StaticDNSRecord
+
+		err := gofakeit.Struct(&a)
+		if err != nil {
+			return results, err
+		}
+
+		results[i] = &a
+	}
+
+	return results, nil
+}
+
+// GetDHCPOptions returns a mocked list of DHCP options
+func (m *MockUnifi) GetDHCPOptions(_ *unifi.Site) ([]*unifi.DHCPOption, error) {
+	results := make([]*unifi.DHCPOption, numItemsMocked)
+
+	for i := 0; i < numItemsMocked; i++ {
+		var a unifi.DHCPOption
+
+		err := gofakeit.Struct(&a)
+		if err != nil {
+			return results, err
+		}
+
+		results[i] = &a
+	}
+
+	return results, nil
+}
+
+// GetMDNSConfiguration returns a mocked mDNS configuration
+func (m *MockUnifi) GetMDNSConfiguration(_ *unifi.Site) (*unifi.MDNSConfiguration, error) {
+	var a unifi.MDNSConfiguration
+
+	err := gofakeit.Struct(&a)
+	if err != nil {
+		return nil, err
+	}
+
+	return &a, nil
+}
+
+// GetGlobalNetworkConfig returns a mocked global network config
+func (m *MockUnifi) GetGlobalNetworkConfig(_ *unifi.Site) (*unifi.GlobalNetworkConfig, error) {
+	var a unifi.GlobalNetworkConfig
+
+	err := gofakeit.Struct(&a)
+	if err != nil {
+		return nil, err
+	}
+
+	return &a, nil
+}
+
+// GetExcludedIPs returns a mocked list of excluded IP ranges
+func (m *MockUnifi) GetExcludedIPs(_ *unifi.Site) ([]*unifi.ExcludedIPRange, error) {
+	results := make([]*unifi.ExcludedIPRange, numItemsMocked)
+
+	for i := 0; i < numItemsMocked; i++ {
+		var a unifi.ExcludedIPRange
+
+		err := gofakeit.Struct(&a)
+		if err != nil {
+			return results, err
+		}
+
+		results[i] = &a
+	}
+
+	return results, nil
+}
+
+// GetWANNetworkGroups returns a mocked list of WAN network groups
+func (m *MockUnifi) GetWANNetworkGroups(_ *unifi.Site) ([]*unifi.WANNetworkGroup, error) {
+	results := make([]*unifi.WANNetworkGroup, numItemsMocked)
+
+	for i := 0; i < numItemsMocked; i++ {
+		var a unifi.WANNetworkGroup
+
+		err := gofakeit.Struct(&a)
+		if err != nil {
+			return results, err
+		}
+
+		results[i] = &a
+	}
+
+	return results, nil
+}
+
+// GetWANMagicConfiguration returns a mocked list of WAN magic configurations
+func (m *MockUnifi) GetWANMagicConfiguration(_ *unifi.Site) ([]*unifi.WANMagicConfiguration, error) {
+	results := make([]*unifi.WANMagicConfiguration, numItemsMocked)
+
+	for i := 0; i < numItemsMocked; i++ {
+		var a unifi.WANMagicConfiguration
+
+		err := gofakeit.Struct(&a)
+		if err != nil {
+			return results, err
+		}
+
+		results[i] = &a
+	}
+
+	return results, nil
+}
+
+// GetWANMagicSubscription returns a mocked list of WAN magic subscriptions
+func (m *MockUnifi) GetWANMagicSubscription(_ *unifi.Site) ([]*unifi.WANMagicSubscription, error) {
+	results := make([]*unifi.WANMagicSubscription, numItemsMocked)
+
+	for i := 0; i < numItemsMocked; i++ {
+		var a unifi.WANMagicSubscription
+
+		err := gofakeit.Struct(&a)
+		if err != nil {
+			return results, err
+		}
+
+		results[i] = &a
+	}
+
+	return results, nil
+}
+
+// GetWANOverview returns a mocked list of WAN overviews
+func (m *MockUnifi) GetWANOverview(_ *unifi.Site) ([]*unifi.WANOverview, error) {
+	results := make([]*unifi.WANOverview, numItemsMocked)
+
+	for i := 0; i < numItemsMocked; i++ {
+		var a unifi.WANOverview
+
+		err := gofakeit.Struct(&a)
+		if err != nil {
+			return results, err
+		}
+
+		results[i] = &a
+	}
+
+	return results, nil
+}
+
+// GetSiteHealth returns a mocked subsystem health summary
+func (m *MockUnifi) GetSiteHealth(_ *unifi.Site) ([]*unifi.SubsystemHealth, error) {
+	results := make([]*unifi.SubsystemHealth, numItemsMocked)
+
+	for i := 0; i < numItemsMocked; i++ {
+		var a unifi.SubsystemHealth
+
+		err := gofakeit.Struct(&a)
+		if err != nil {
+			return results, err
+		}
+
+		results[i] = &a
+	}
+
+	return results, nil
+}
+
+// GetSDNStatus returns a mocked SDN status
+func (m *MockUnifi) GetSDNStatus(_ *unifi.Site) (*unifi.SDNStatus, error) {
+	var a unifi.SDNStatus
+
+	err := gofakeit.Struct(&a)
+	if err != nil {
+		return nil, err
+	}
+
+	return &a, nil
+}
+
+// GetWidgetWarnings returns a mocked warnings widget
+func (m *MockUnifi) GetWidgetWarnings(_ *unifi.Site) (*unifi.WidgetWarnings, error) {
+	var a unifi.WidgetWarnings
+
+	err := gofakeit.Struct(&a)
+	if err != nil {
+		return nil, err
+	}
+
+	return &a, nil
+}
+
+// FirmwareReport returns a mocked firmware report
+func (m *MockUnifi) FirmwareReport(_ []*unifi.Site) (*unifi.FirmwareReport, error) {
+	var a unifi.FirmwareReport
+
+	err := gofakeit.Struct(&a)
+	if err != nil {
+		return nil, err
+	}
+
+	return &a, nil
+}
+
+// GetAPGroups returns a mocked list of AP groups
+func (m *MockUnifi) GetAPGroups(_ *unifi.Site) ([]*unifi.APGroup, error) {
+	results := make([]*unifi.APGroup, numItemsMocked)
+
+	for i := 0; i < numItemsMocked; i++ {
+		var a unifi.APGroup
+
+		err := gofakeit.Struct(&a)
+		if err != nil {
+			return results, err
+		}
+
+		results[i] = &a
+	}
+
+	return results, nil
+}
+
+// GetWirelessLinks returns a mocked list of wireless links
+func (m *MockUnifi) GetWirelessLinks(_ *unifi.Site) ([]*unifi.WirelessLink, error) {
+	results := make([]*unifi.WirelessLink, numItemsMocked)
+
+	for i := 0; i < numItemsMocked; i++ {
+		var a unifi.WirelessLink
+
+		err := gofakeit.Struct(&a)
+		if err != nil {
+			return results, err
+		}
+
+		results[i] = &a
+	}
+
+	return results, nil
+}
+
+// GetPortMACTables returns learned MAC addresses per switch port.
+func (m *MockUnifi) GetPortMACTables(_ *unifi.Site, _ ...string) ([]*unifi.PortMACTable, error) {
+	results := make([]*unifi.PortMACTable, numItemsMocked)
+
+	for i := 0; i < numItemsMocked; i++ {
+		var a unifi.PortMACTable
+
+		err := gofakeit.Struct(&a)
+		if err != nil {
+			return results, err
+		}
+
+		results[i] = &a
+	}
+
+	return results, nil
+}
+
+// LocateMAC returns the switch port where a MAC was learned.
+func (m *MockUnifi) LocateMAC(_ *unifi.Site, _ string) (*unifi.MACLocation, error) {
+	var a unifi.MACLocation
+
+	err := gofakeit.Struct(&a)
+	if err != nil {
+		return nil, err
+	}
+
+	return &a, nil
+}
+
+// GetLegacyRADIUSProfiles returns RADIUS profiles from the legacy REST API.
+func (m *MockUnifi) GetLegacyRADIUSProfiles(_ *unifi.Site) ([]*unifi.LegacyRADIUSProfile, error) {
+	results := make([]*unifi.LegacyRADIUSProfile, numItemsMocked)
+
+	for i := 0; i < numItemsMocked; i++ {
+		var a unifi.LegacyRADIUSProfile
+
+		err := gofakeit.Struct(&a)
+		if err != nil {
+			return results, err
+		}
+
+		results[i] = &a
+	}
+
+	return results, nil
+}
+
+// GetRADIUSUsers returns users of the built-in RADIUS server.
+func (m *MockUnifi) GetRADIUSUsers(_ *unifi.Site) ([]*unifi.RADIUSUser, error) {
+	results := make([]*unifi.RADIUSUser, numItemsMocked)
+
+	for i := 0; i < numItemsMocked; i++ {
+		var a unifi.RADIUSUser
+
+		err := gofakeit.Struct(&a)
+		if err != nil {
+			return results, err
+		}
+
+		results[i] = &a
+	}
+
+	return results, nil
+}
+
+// CreateRADIUSUser adds a user to the built-in RADIUS server.
+func (m *MockUnifi) CreateRADIUSUser(_ *unifi.Site, user *unifi.RADIUSUser) (*unifi.RADIUSUser, error) {
+	created := *user
+	created.ID = gofakeit.UUID()
+
+	return &created, nil
+}
+
+// UpdateRADIUSUser replaces an existing RADIUS user.
+func (m *MockUnifi) UpdateRADIUSUser(_ *unifi.Site, user *unifi.RADIUSUser) (*unifi.RADIUSUser, error) {
+	updated := *user
+
+	return &updated, nil
+}
+
+// DeleteRADIUSUser removes a user from the built-in RADIUS server.
+func (m *MockUnifi) DeleteRADIUSUser(_ *unifi.Site, _ string) error {
+	return nil
+}
+
+// GetDynamicDNS returns dynamic DNS configurations for a site.
+func (m *MockUnifi) GetDynamicDNS(_ *unifi.Site) ([]*unifi.DynamicDNS, error) {
+	results := make([]*unifi.DynamicDNS, numItemsMocked)
+
+	for i := 0; i < numItemsMocked; i++ {
+		var a unifi.DynamicDNS
+
+		err := gofakeit.Struct(&a)
+		if err != nil {
+			return results, err
+		}
+
+		results[i] = &a
+	}
+
+	return results, nil
+}
+
+// GetDynamicDNSStatus returns the last dynamic DNS updates for a site.
+func (m *MockUnifi) GetDynamicDNSStatus(_ *unifi.Site) ([]*unifi.DynamicDNSStatus, error) {
+	results := make([]*unifi.DynamicDNSStatus, numItemsMocked)
+
+	for i := 0; i < numItemsMocked; i++ {
+		var a unifi.DynamicDNSStatus
+
+		err := gofakeit.Struct(&a)
+		if err != nil {
+			return results, err
+		}
+
+		results[i] = &a
+	}
+
+	return results, nil
+}
+
+// GetDynamicDNSHealth reports whether each dynamic DNS hostname is in sync.
+func (m *MockUnifi) GetDynamicDNSHealth(_ *unifi.Site) ([]*unifi.DynamicDNSCheck, error) {
+	results := make([]*unifi.DynamicDNSCheck, numItemsMocked)
+
+	for i := 0; i < numItemsMocked; i++ {
+		var a unifi.DynamicDNSCheck
+
+		err := gofakeit.Struct(&a)
+		if err != nil {
+			return results, err
+		}
+
+		results[i] = &a
+	}
+
+	return results, nil
+}
+
+// GetScheduleTasks returns scheduled tasks for a site.
+func (m *MockUnifi) GetScheduleTasks(_ *unifi.Site) ([]*unifi.ScheduleTask, error) {
+	results := make([]*unifi.ScheduleTask, numItemsMocked)
+
+	for i := 0; i < numItemsMocked; i++ {
+		var a unifi.ScheduleTask
+
+		err := gofakeit.Struct(&a)
+		if err != nil {
+			return results, err
+		}
+
+		results[i] = &a
+	}
+
+	return results, nil
+}
+
+// GetHotspotPackages returns hotspot payment packages for a site.
+func (m *MockUnifi) GetHotspotPackages(_ *unifi.Site) ([]*unifi.HotspotPackage, error) {
+	results := make([]*unifi.HotspotPackage, numItemsMocked)
+
+	for i := 0; i < numItemsMocked; i++ {
+		var a unifi.HotspotPackage
+
+		err := gofakeit.Struct(&a)
+		if err != nil {
+			return results, err
+		}
+
+		results[i] = &a
+	}
+
+	return results, nil
+}
+
+// Capabilities returns the probed capabilities of a site.
+func (m *MockUnifi) Capabilities(_ *unifi.Site) (*unifi.Capabilities, error) {
+	return &unifi.Capabilities{
+		MajorVersion: 9,
+		Features:     map[string]bool{"UPS_ADOPTED": gofakeit.Bool()},
+		WLAN:         map[string]any{"wpa3_supported": gofakeit.Bool()},
+	}, nil
+}
+
+// FeatureExists reports whether a controller feature flag is set for a site.
+func (m *MockUnifi) FeatureExists(_ *unifi.Site, _ string) (bool, error) {
+	return gofakeit.Bool(), nil
+}
+
+// GetDPIApps returns user-defined DPI restrictions (blocked or rate limited apps) for a site.
+func (m *MockUnifi) GetDPIApps(_ *unifi.Site) ([]*unifi.DPIApp, error) {
+	results := make([]*unifi.DPIApp, numItemsMocked)
+
+	for i := 0; i < numItemsMocked; i++ {
+		var a unifi.DPIApp
+
+		err := gofakeit.Struct(&a)
+		if err != nil {
+			return results, err
+		}
+
+		results[i] = &a
+	}
+
+	return results, nil
+}
+
+// GetDPIGroups returns user-defined DPI groups for a site.
+func (m *MockUnifi) GetDPIGroups(_ *unifi.Site) ([]*unifi.DPIGroup, error) {
+	results := make([]*unifi.DPIGroup, numItemsMocked)
+
+	for i := 0; i < numItemsMocked; i++ {
+		var a unifi.DPIGroup
+
+		err := gofakeit.Struct(&a)
+		if err != nil {
+			return results, err
+		}
+
+		results[i] = &a
+	}
+
+	return results, nil
+}
+
+// RefreshDPIMaps leaves DPIApps and DPICats untouched and reports no names added.
+func (m *MockUnifi) RefreshDPIMaps() (int, error) {
+	return 0, nil
+}
+
+// GetCountryCodes returns the regulatory domains the controller knows about.
+func (m *MockUnifi) GetCountryCodes(_ *unifi.Site) ([]*unifi.CountryCode, error) {
+	results := make([]*unifi.CountryCode, numItemsMocked)
+
+	for i := 0; i < numItemsMocked; i++ {
+		var a unifi.CountryCode
+
+		err := gofakeit.Struct(&a)
+		if err != nil {
+			return results, err
+		}
+
+		results[i] = &a
+	}
+
+	return results, nil
+}
+
+// GetChannelPlan returns the channels allowed for the site's country code.
+func (m *MockUnifi) GetChannelPlan(_ *unifi.Site) (*unifi.ChannelPlan, error) {
+	var a unifi.ChannelPlan
+
+	err := gofakeit.Struct(&a)
+	if err != nil {
+		return nil, err
+	}
+
+	return &a, nil
+}
+
+// CheckUAPRadios validates each access point radio's channel, width and tx power against the site's channel plan.
+func (m *MockUnifi) CheckUAPRadios(_ *unifi.Site) ([]*unifi.RadioIssue, error) {
+	results := make([]*unifi.RadioIssue, numItemsMocked)
+
+	for i := 0; i < numItemsMocked; i++ {
+		var a unifi.RadioIssue
+
+		err := gofakeit.Struct(&a)
+		if err != nil {
+			return results, err
+		}
+
+		results[i] = &a
+	}
+
+	return results, nil
+}
+
+// GetFingerprintDatabase returns one page of the client fingerprint database.
+func (m *MockUnifi) GetFingerprintDatabase(_ int) (*unifi.FingerprintDatabase, error) {
+	var a unifi.FingerprintDatabase
+
+	err := gofakeit.Struct(&a)
+	if err != nil {
+		return nil, err
+	}
+
+	return &a, nil
+}
+
+// GetVendorIDs returns the vendor names behind client dev_vendor IDs for a site.
+func (m *MockUnifi) GetVendorIDs(_ *unifi.Site) ([]*unifi.VendorID, error) {
+	results := make([]*unifi.VendorID, numItemsMocked)
+
+	for i := 0; i < numItemsMocked; i++ {
+		var a unifi.VendorID
+
+		err := gofakeit.Struct(&a)
+		if err != nil {
+			return results, err
+		}
+
+		results[i] = &a
+	}
+
+	return results, nil
+}
+
+// Fingerprints returns the cached fingerprint tables used to name client vendors, families and OSes.
+func (m *MockUnifi) Fingerprints(_ *unifi.Site) (*unifi.Fingerprints, error) {
+	db, err := m.GetFingerprintDatabase(0)
+	if err != nil {
+		return nil, err
+	}
+
+	return unifi.NewFingerprints(&unifi.FingerprintSnapshot{Databases: []*unifi.FingerprintDatabase{db}}), nil
+}
+
+// SetFingerprints does nothing; Fingerprints always returns generated tables.
+func (m *MockUnifi) SetFingerprints(_ *unifi.Fingerprints) {}
+
+// UsersAPI returns a UniFi OS users API without a console; its requests return unifi.ErrNilUnifi.
+func (m *MockUnifi) UsersAPI() *unifi.UsersAPI {
+	return (&unifi.Unifi{}).UsersAPI()
+}
+
 // AuthorizeGuest authorizes a guest client by MAC on a site.
 func (m *MockUnifi) AuthorizeGuest(_ *unifi.Site, _ string, _ int) error {
 	return nil
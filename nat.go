@@ -0,0 +1,85 @@
+package unifi
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// NAT rule types reported in NATRule.Type.
+const (
+	NATTypeMasquerade = "MASQUERADE"
+	NATTypeDNAT       = "DNAT"
+	NATTypeSNAT       = "SNAT"
+)
+
+// GetNATRules returns NAT rules (masquerade, source and destination NAT) for a single site.
+// Auto-generated rules are included and flagged with IsPredefined.
+// Uses the v2 API endpoint: GET /proxy/network/v2/api/site/{site}/nat
+func (u *Unifi) GetNATRules(site *Site) ([]*NATRule, error) {
+	if site == nil || site.Name == "" {
+		return nil, ErrNoSiteProvided
+	}
+
+	u.DebugLog("Polling Controller for NAT rules, site %s", site.SiteName)
+
+	body, err := u.GetJSON(fmt.Sprintf(APINATRulesPath, site.Name))
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch NAT rules for site %s: %w", site.SiteName, err)
+	}
+
+	var raw []*NATRule
+	if err := json.Unmarshal(body, &raw); err != nil {
+		return nil, fmt.Errorf("failed to parse NAT rules for site %s: %w", site.SiteName, err)
+	}
+
+	rules := make([]*NATRule, 0, len(raw))
+
+	for _, r := range raw {
+		if r == nil {
+			continue
+		}
+
+		r.SiteName = site.SiteName
+		r.SourceName = u.URL
+		rules = append(rules, r)
+	}
+
+	return rules, nil
+}
+
+// NATRule represents a gateway NAT rule.
+// IPAddress and Port are the translated address and port for DNAT/SNAT rules.
+type NATRule struct {
+	ID                string        `fake:"{uuid}"                                json:"_id"`
+	Description       string        `fake:"{sentence:5}"                          json:"description"`
+	DestinationFilter NATRuleFilter `json:"destination_filter"`
+	Enabled           FlexBool      `json:"enabled"`
+	Exclude           FlexBool      `json:"exclude"`
+	InInterface       string        `json:"in_interface,omitempty"`
+	IPAddress         string        `fake:"{ipv4address}"                         json:"ip_address,omitempty"`
+	IPVersion         string        `fake:"{randomstring:[IPV4,IPV6,BOTH]}"       json:"ip_version"`
+	IsPredefined      FlexBool      `json:"is_predefined"`
+	Logging           FlexBool      `json:"logging"`
+	OutInterface      string        `json:"out_interface,omitempty"`
+	Port              string        `json:"port,omitempty"`
+	Protocol          string        `fake:"{randomstring:[all,tcp,udp,tcp_udp]}"  json:"protocol"`
+	RuleIndex         FlexInt       `json:"rule_index"`
+	SettingPreference string        `json:"setting_preference"`
+	SourceFilter      NATRuleFilter `json:"source_filter"`
+	Type              string        `fake:"{randomstring:[MASQUERADE,DNAT,SNAT]}" json:"type"`
+
+	SiteName   string `json:"-"`
+	SourceName string `json:"-"`
+}
+
+// NATRuleFilter is the source or destination match of a NATRule.
+// FirewallGroupIDs reference FirewallGroup.ID.
+type NATRuleFilter struct {
+	Address          string   `fake:"{ipv4address}"                                       json:"address,omitempty"`
+	FilterType       string   `fake:"{randomstring:[NONE,ADDRESS_AND_PORT,NETWORK_CONF]}" json:"filter_type"`
+	FirewallGroupIDs []string `json:"firewall_group_ids,omitempty"`
+	InvertAddress    FlexBool `json:"invert_address"`
+	InvertPort       FlexBool `json:"invert_port"`
+	NetworkConfID    string   `json:"network_conf_id,omitempty"`
+	Port             string   `json:"port,omitempty"`
+}
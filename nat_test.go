@@ -0,0 +1,20 @@
+package unifi_test
+
+import (
+	"testing"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/stretchr/testify/require"
+	"github.com/unpoller/unifi/v5"
+)
+
+func TestNATRuleStruct(t *testing.T) {
+	t.Parallel()
+
+	var r unifi.NATRule
+
+	err := gofakeit.Struct(&r)
+	require.NoError(t, err)
+	require.NotEmpty(t, r.ID)
+	require.Contains(t, []string{unifi.NATTypeMasquerade, unifi.NATTypeDNAT, unifi.NATTypeSNAT}, r.Type)
+}
@@ -66,7 +66,10 @@ type Network struct {
 	WANLoadBalanceType  string  `json:"wan_load_balance_type"`
 
 	// IPv6
-	IPv6Enabled FlexBool `json:"ipv6_enabled"`
+	IPv6Enabled    FlexBool `json:"ipv6_enabled"`
+	DhcpdV6Enabled FlexBool `json:"dhcpdv6_enabled"`
+	DhcpdV6Start   string   `json:"dhcpdv6_start"`
+	DhcpdV6Stop    string   `json:"dhcpdv6_stop"`
 
 	// VPN
 	SDWANRemoteSiteID string `json:"sdwan_remote_site_id"`
@@ -75,6 +78,8 @@ type Network struct {
 	// Advanced DHCP
 	DhcpdNTPEnabled  FlexBool `json:"dhcpd_ntp_enabled"`
 	DhcpdWINSEnabled FlexBool `json:"dhcpd_wins_enabled"`
+	DhcpdStart       string   `json:"dhcpd_start"`
+	DhcpdStop        string   `json:"dhcpd_stop"`
 
 	// Misc
 	FirewallZoneID string   `json:"firewall_zone_id"`
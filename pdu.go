@@ -103,6 +103,7 @@ type PDU struct {
 	Type                                string      `fake:"{lexify:pdu}"              json:"type"`
 	Unsupported                         FlexBool    `json:"unsupported"`
 	UnsupportedReason                   FlexInt     `json:"unsupported_reason"`
+	UpgradeToFirmware                   string      `json:"upgrade_to_firmware"`
 	Upgradeable                         FlexBool    `json:"upgradable"`
 	Uplink                              Uplink      `json:"uplink"`
 	UplinkDepth                         FlexBool    `json:"uplink_depth"`
@@ -1,6 +1,9 @@
 package unifi
 
-import "fmt"
+import (
+	"fmt"
+	"time"
+)
 
 // PortForward represents a port forwarding rule from /api/s/{site}/rest/portforward.
 type PortForward struct {
@@ -16,6 +19,26 @@ type PortForward struct {
 	DstPort string   `fake:"{number:1,65535}"                 json:"dst_port"`
 	Log     FlexBool `json:"log"`
 
+	// Stats is populated by JoinPortForwardStats.
+	Stats *PortForwardStats `json:"-"`
+
+	SiteName   string `json:"-"`
+	SourceName string `json:"-"`
+}
+
+// PortForwardStats represents port forward hit counters from /api/s/{site}/stat/portforward.
+// ID matches PortForward.ID. Counters reset when the gateway reboots or is reprovisioned.
+type PortForwardStats struct {
+	ID          string  `fake:"{uuid}"     json:"_id"`
+	Bytes       FlexInt `json:"bytes"`
+	LastMatched FlexInt `json:"last_matched"` // Unix timestamp in seconds, 0 when never matched
+	Name        string  `fake:"{buzzword}" json:"name"`
+	Packets     FlexInt `json:"packets"`
+	RxBytes     FlexInt `json:"rx_bytes"`
+	RxPackets   FlexInt `json:"rx_packets"`
+	TxBytes     FlexInt `json:"tx_bytes"`
+	TxPackets   FlexInt `json:"tx_packets"`
+
 	SiteName   string `json:"-"`
 	SourceName string `json:"-"`
 }
@@ -49,3 +72,82 @@ func (u *Unifi) GetPortForwards(site *Site) ([]*PortForward, error) {
 
 	return result, nil
 }
+
+// GetPortForwardStats returns port forward hit counters for a single site.
+// Uses the legacy API endpoint: GET /api/s/{site}/stat/portforward.
+func (u *Unifi) GetPortForwardStats(site *Site) ([]*PortForwardStats, error) {
+	if site == nil || site.Name == "" {
+		return nil, ErrNoSiteProvided
+	}
+
+	u.DebugLog("Polling Controller for port forward stats, site %s", site.SiteName)
+
+	path := fmt.Sprintf(APIPortForwardStatsPath, site.Name)
+
+	var response struct {
+		Data []PortForwardStats `json:"data"`
+	}
+
+	if err := u.GetData(path, &response); err != nil {
+		return nil, fmt.Errorf("fetching port forward stats for site %s: %w", site.SiteName, err)
+	}
+
+	result := make([]*PortForwardStats, len(response.Data))
+
+	for i := range response.Data {
+		response.Data[i].SiteName = site.SiteName
+		response.Data[i].SourceName = u.URL
+		result[i] = &response.Data[i]
+	}
+
+	return result, nil
+}
+
+// JoinPortForwardStats attaches stats to the port forwards with a matching ID.
+// Forwards without stats keep a nil Stats field.
+func JoinPortForwardStats(forwards []*PortForward, stats []*PortForwardStats) {
+	byID := make(map[string]*PortForwardStats, len(stats))
+
+	for _, s := range stats {
+		if s != nil {
+			byID[s.ID] = s
+		}
+	}
+
+	for _, f := range forwards {
+		if f != nil {
+			f.Stats = byID[f.ID]
+		}
+	}
+}
+
+// Matched returns true if traffic has hit the rule since the given time, based on
+// the last-matched timestamp. Stats must have been joined with JoinPortForwardStats.
+// A forward without stats or without a last-matched time is treated as not matched:
+// its packet counters add up from the gateway's last reboot, so they cannot show
+// when the rule was last used.
+func (p *PortForward) Matched(since time.Time) bool {
+	if p.Stats == nil {
+		return false
+	}
+
+	last := p.Stats.LastMatched.Int64()
+
+	return last > 0 && !time.Unix(last, 0).Before(since)
+}
+
+// UnusedPortForwards returns the enabled port forwards that have not matched any
+// traffic within the given duration, e.g. 90*24*time.Hour for a quarterly review.
+// Stats must have been joined with JoinPortForwardStats first.
+func UnusedPortForwards(forwards []*PortForward, within time.Duration) []*PortForward {
+	since := time.Now().Add(-within)
+	unused := make([]*PortForward, 0)
+
+	for _, f := range forwards {
+		if f != nil && f.Enabled.Val && !f.Matched(since) {
+			unused = append(unused, f)
+		}
+	}
+
+	return unused
+}
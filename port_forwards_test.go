@@ -2,6 +2,7 @@ package unifi_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/brianvoe/gofakeit/v6"
 	"github.com/stretchr/testify/require"
@@ -18,3 +19,50 @@ func TestPortForwardStruct(t *testing.T) {
 	require.NotEmpty(t, p.ID)
 	require.NotEmpty(t, p.Name)
 }
+
+func TestPortForwardStatsStruct(t *testing.T) {
+	t.Parallel()
+
+	var s unifi.PortForwardStats
+
+	err := gofakeit.Struct(&s)
+	require.NoError(t, err)
+	require.NotEmpty(t, s.ID)
+}
+
+func TestUnusedPortForwards(t *testing.T) {
+	t.Parallel()
+
+	recent := time.Now().Add(-24 * time.Hour).Unix()
+	stale := time.Now().Add(-120 * 24 * time.Hour).Unix()
+	forwards := []*unifi.PortForward{
+		{ID: "recent", Enabled: *unifi.NewFlexBool(true)},
+		{ID: "stale", Enabled: *unifi.NewFlexBool(true)},
+		{ID: "counted", Enabled: *unifi.NewFlexBool(true)},
+		{ID: "nostats", Enabled: *unifi.NewFlexBool(true)},
+		{ID: "disabled", Enabled: *unifi.NewFlexBool(false)},
+		nil,
+	}
+	stats := []*unifi.PortForwardStats{
+		{ID: "recent", LastMatched: *unifi.NewFlexInt(float64(recent))},
+		{ID: "stale", LastMatched: *unifi.NewFlexInt(float64(stale))},
+		// Counters without a timestamp may be from long ago on a gateway that has not rebooted.
+		{ID: "counted", Packets: *unifi.NewFlexInt(12), RxPackets: *unifi.NewFlexInt(7)},
+		nil,
+	}
+
+	unifi.JoinPortForwardStats(forwards, stats)
+	require.NotNil(t, forwards[0].Stats)
+	require.Nil(t, forwards[3].Stats)
+
+	unused := unifi.UnusedPortForwards(forwards, 90*24*time.Hour)
+	ids := make([]string, 0, len(unused))
+
+	for _, f := range unused {
+		ids = append(ids, f.ID)
+	}
+
+	require.ElementsMatch(t, []string{"stale", "counted", "nostats"}, ids)
+	require.False(t, forwards[2].Matched(time.Now().Add(-200*24*time.Hour)),
+		"counters without a last-matched time must not count as a match for an old since")
+}
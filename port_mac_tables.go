@@ -0,0 +1,292 @@
+package unifi
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+// ErrMACNotFound is returned by LocateMAC and MatchMACLocation when no switch port has learned the MAC.
+var ErrMACNotFound = errors.New("mac address not found in any switch port mac table")
+
+// PortMACTable holds the MAC addresses learned on each port of one switch,
+// from POST /v2/api/site/{site}/ports/mac-tables.
+type PortMACTable struct {
+	DeviceMac string             `fake:"{macaddress}" json:"mac"`
+	Ports     []PortMACTablePort `fakesize:"2"        json:"ports"`
+
+	SiteName   string `json:"-"`
+	SourceName string `json:"-"`
+}
+
+// PortMACTablePort is the learned MAC list of a single switch port.
+type PortMACTablePort struct {
+	PortIdx  FlexInt        `json:"port_idx"`
+	MacTable []PortMACEntry `fakesize:"2" json:"mac_table"`
+}
+
+// PortMACEntry is a MAC address learned on a switch port. Age is in seconds.
+type PortMACEntry struct {
+	Age      FlexInt  `json:"age"`
+	Hostname string   `fake:"{domainname}"  json:"hostname,omitempty"`
+	IP       string   `fake:"{ipv4address}" json:"ip,omitempty"`
+	Mac      string   `fake:"{macaddress}"  json:"mac"`
+	Static   FlexBool `json:"static"`
+	Vlan     FlexInt  `json:"vlan"`
+}
+
+// MACLocation is the switch port where a MAC address was learned.
+type MACLocation struct {
+	DeviceMac  string
+	DeviceName string
+	PortIdx    int
+	PortName   string
+	Vlan       int
+	IsUplink   bool // true when the MAC was only seen on uplinks or ports leading to other devices
+	IP         string
+	Hostname   string
+	SiteName   string
+	SourceName string
+}
+
+// GetPortMACTables returns the MAC addresses learned on every switch port for a site.
+// Pass deviceMACs to limit the result to specific switches; none returns all of them.
+// Uses the v2 API endpoint: POST /proxy/network/v2/api/site/{site}/ports/mac-tables
+func (u *Unifi) GetPortMACTables(site *Site, deviceMACs ...string) ([]*PortMACTable, error) {
+	if site == nil || site.Name == "" {
+		return nil, ErrNoSiteProvided
+	}
+
+	u.DebugLog("Polling Controller for port MAC tables, site %s", site.SiteName)
+
+	if deviceMACs == nil {
+		deviceMACs = []string{}
+	}
+
+	reqJSON, err := json.Marshal(struct {
+		Macs []string `json:"macs"`
+	}{Macs: deviceMACs})
+	if err != nil {
+		return nil, fmt.Errorf("marshaling port mac tables request: %w", err)
+	}
+
+	body, err := u.GetJSON(fmt.Sprintf(APIPortMACTablesPath, site.Name), string(reqJSON))
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch port MAC tables for site %s: %w", site.SiteName, err)
+	}
+
+	var raw []*PortMACTable
+	if err := json.Unmarshal(body, &raw); err != nil {
+		return nil, fmt.Errorf("failed to parse port MAC tables for site %s: %w", site.SiteName, err)
+	}
+
+	tables := make([]*PortMACTable, 0, len(raw))
+
+	for _, t := range raw {
+		if t == nil {
+			continue
+		}
+
+		t.SiteName = site.SiteName
+		t.SourceName = u.URL
+		tables = append(tables, t)
+	}
+
+	return tables, nil
+}
+
+// LocateMAC fetches the port MAC tables and devices for a site and returns the
+// switch port where mac was learned. See MatchMACLocation.
+func (u *Unifi) LocateMAC(site *Site, mac string) (*MACLocation, error) {
+	if mac == "" {
+		return nil, ErrEmptyMAC
+	}
+
+	tables, err := u.GetPortMACTables(site)
+	if err != nil {
+		return nil, err
+	}
+
+	devices, err := u.GetDevices([]*Site{site})
+	if err != nil {
+		return nil, err
+	}
+
+	return MatchMACLocation(mac, tables, devices)
+}
+
+// switchPorts is the name and port table of a device that reports switch ports.
+type switchPorts struct {
+	name  string
+	ports []Port
+}
+
+// deviceLinks describes how adopted devices connect to each other.
+type deviceLinks struct {
+	switches  map[string]switchPorts
+	parents   map[string]string       // device MAC to the MAC of the device it uplinks to
+	downlinks map[string]map[int]bool // switch MAC to the ports other devices uplink through
+}
+
+// MatchMACLocation finds mac in tables and returns where it was learned. A MAC
+// is seen on its edge port and on every port between that switch and the querying
+// controller, so edge ports win over ports flagged Port.IsUplink and over ports
+// another adopted device uplinks through (its Uplink.UplinkMac and UplinkRemotePort).
+// When several edge ports remain, the one on the switch furthest from the gateway
+// wins. Non-edge ports are only used when no edge port has the MAC. devices may be
+// nil; ports are then treated as edge ports.
+func MatchMACLocation(mac string, tables []*PortMACTable, devices *Devices) (*MACLocation, error) {
+	if mac == "" {
+		return nil, ErrEmptyMAC
+	}
+
+	links := newDeviceLinks(devices)
+	mac = normalizeMAC(mac)
+
+	var (
+		edge, uplink *MACLocation
+		edgeDepth    int
+	)
+
+	for _, table := range tables {
+		if table == nil {
+			continue
+		}
+
+		device := normalizeMAC(table.DeviceMac)
+		sw := links.switches[device]
+
+		for _, port := range table.Ports {
+			for _, entry := range port.MacTable {
+				if normalizeMAC(entry.Mac) != mac {
+					continue
+				}
+
+				loc := &MACLocation{
+					DeviceMac:  table.DeviceMac,
+					DeviceName: sw.name,
+					PortIdx:    port.PortIdx.Int(),
+					Vlan:       entry.Vlan.Int(),
+					IP:         entry.IP,
+					Hostname:   entry.Hostname,
+					SiteName:   table.SiteName,
+					SourceName: table.SourceName,
+					IsUplink:   links.downlinks[device][port.PortIdx.Int()],
+				}
+
+				for _, p := range sw.ports {
+					if p.PortIdx.Int() == loc.PortIdx {
+						loc.PortName = p.Name
+						loc.IsUplink = loc.IsUplink || p.IsUplink.Val
+
+						break
+					}
+				}
+
+				switch depth := links.depth(device); {
+				case loc.IsUplink && uplink == nil:
+					uplink = loc
+				case !loc.IsUplink && (edge == nil || depth > edgeDepth):
+					edge, edgeDepth = loc, depth
+				}
+			}
+		}
+	}
+
+	if edge != nil {
+		return edge, nil
+	}
+
+	if uplink != nil {
+		return uplink, nil
+	}
+
+	return nil, fmt.Errorf("%w: %s", ErrMACNotFound, mac)
+}
+
+// newDeviceLinks maps normalized device MACs to their names, port tables and uplinks.
+func newDeviceLinks(devices *Devices) *deviceLinks {
+	links := &deviceLinks{
+		switches:  make(map[string]switchPorts),
+		parents:   make(map[string]string),
+		downlinks: make(map[string]map[int]bool),
+	}
+
+	if devices == nil {
+		return links
+	}
+
+	addSwitch := func(mac, name string, ports []Port) {
+		links.switches[normalizeMAC(mac)] = switchPorts{name: name, ports: ports}
+	}
+
+	for _, d := range devices.USWs {
+		addSwitch(d.Mac, d.Name, d.PortTable)
+		links.add(d.Mac, d.Uplink.UplinkMac, d.Uplink.UplinkRemotePort.Int())
+	}
+
+	for _, d := range devices.UDMs {
+		addSwitch(d.Mac, d.Name, d.PortTable)
+		links.add(d.Mac, d.Uplink.UplinkMac, d.Uplink.UplinkRemotePort.Int())
+	}
+
+	for _, d := range devices.UXGs {
+		addSwitch(d.Mac, d.Name, d.PortTable)
+		links.add(d.Mac, d.Uplink.UplinkMac, d.Uplink.UplinkRemotePort.Int())
+	}
+
+	for _, d := range devices.PDUs {
+		addSwitch(d.Mac, d.Name, d.PortTable)
+		links.add(d.Mac, d.Uplink.UplinkMac, d.Uplink.UplinkRemotePort.Int())
+	}
+
+	for _, d := range devices.UAPs {
+		links.add(d.Mac, d.Uplink.UplinkMac, d.Uplink.UplinkRemotePort)
+	}
+
+	for _, d := range devices.UDBs {
+		links.add(d.Mac, d.Uplink.UplinkMac, d.Uplink.UplinkRemotePort.Int())
+	}
+
+	for _, d := range devices.UBBs {
+		if d.Uplink != nil {
+			links.add(d.Mac, d.Uplink.UplinkMac, d.Uplink.UplinkRemotePort.Int())
+		}
+	}
+
+	return links
+}
+
+// add records that device mac uplinks to port on the device with parentMac.
+func (l *deviceLinks) add(mac, parentMac string, port int) {
+	if mac == "" || parentMac == "" {
+		return
+	}
+
+	parent := normalizeMAC(parentMac)
+	l.parents[normalizeMAC(mac)] = parent
+
+	if port <= 0 {
+		return
+	}
+
+	if l.downlinks[parent] == nil {
+		l.downlinks[parent] = make(map[int]bool)
+	}
+
+	l.downlinks[parent][port] = true
+}
+
+// depth returns the number of uplink hops between a device and the top of the
+// tree it belongs to. Loops in the uplink data are cut off.
+func (l *deviceLinks) depth(mac string) int {
+	seen := make(map[string]bool)
+	depth := 0
+
+	for parent, ok := l.parents[mac]; ok && !seen[parent]; parent, ok = l.parents[parent] {
+		seen[parent] = true
+		depth++
+	}
+
+	return depth
+}
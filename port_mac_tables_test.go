@@ -0,0 +1,100 @@
+package unifi_test
+
+import (
+	"testing"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/stretchr/testify/require"
+	"github.com/unpoller/unifi/v5"
+)
+
+func TestPortMACTableStruct(t *testing.T) {
+	t.Parallel()
+
+	var table unifi.PortMACTable
+
+	err := gofakeit.Struct(&table)
+	require.NoError(t, err)
+	require.NotEmpty(t, table.DeviceMac)
+	require.Len(t, table.Ports, 2)
+	require.NotEmpty(t, table.Ports[0].MacTable[0].Mac)
+}
+
+func TestMatchMACLocation(t *testing.T) {
+	t.Parallel()
+
+	// The core switch uplinks to the gateway on port 25 and feeds the office switch
+	// from port 24, which the controller does not flag as an uplink.
+	tables := []*unifi.PortMACTable{
+		{
+			DeviceMac: "aa:aa:aa:aa:aa:01",
+			SiteName:  "default",
+			Ports: []unifi.PortMACTablePort{
+				{PortIdx: *unifi.NewFlexInt(24), MacTable: []unifi.PortMACEntry{
+					{Mac: "bb:bb:bb:bb:bb:01", Vlan: *unifi.NewFlexInt(10)},
+					{Mac: "bb:bb:bb:bb:bb:02", Vlan: *unifi.NewFlexInt(20)},
+				}},
+				{PortIdx: *unifi.NewFlexInt(25), MacTable: []unifi.PortMACEntry{
+					{Mac: "bb:bb:bb:bb:bb:04", Vlan: *unifi.NewFlexInt(1)},
+				}},
+			},
+		},
+		nil,
+		{
+			DeviceMac: "AA:AA:AA:AA:AA:02",
+			Ports: []unifi.PortMACTablePort{
+				{PortIdx: *unifi.NewFlexInt(1), MacTable: []unifi.PortMACEntry{
+					{Mac: "bb:bb:bb:bb:bb:01", Vlan: *unifi.NewFlexInt(10), IP: "192.168.10.50"},
+				}},
+			},
+		},
+	}
+
+	devices := &unifi.Devices{USWs: []*unifi.USW{
+		{Mac: "aa:aa:aa:aa:aa:01", Name: "core", PortTable: []unifi.Port{
+			{PortIdx: *unifi.NewFlexInt(24), Name: "To office"},
+			{PortIdx: *unifi.NewFlexInt(25), Name: "Uplink", IsUplink: *unifi.NewFlexBool(true)},
+		}},
+		{
+			Mac: "aa:aa:aa:aa:aa:02", Name: "office",
+			Uplink:    unifi.Uplink{UplinkMac: "aa:aa:aa:aa:aa:01", UplinkRemotePort: *unifi.NewFlexInt(24)},
+			PortTable: []unifi.Port{{PortIdx: *unifi.NewFlexInt(1), Name: "Desk 1"}},
+		},
+	}}
+
+	// Seen on the core port feeding the office switch and on the office edge port: the edge port wins.
+	loc, err := unifi.MatchMACLocation("BB-BB-BB-BB-BB-01", tables, devices)
+	require.NoError(t, err)
+	require.Equal(t, "office", loc.DeviceName)
+	require.Equal(t, 1, loc.PortIdx)
+	require.Equal(t, "Desk 1", loc.PortName)
+	require.Equal(t, 10, loc.Vlan)
+	require.Equal(t, "192.168.10.50", loc.IP)
+	require.False(t, loc.IsUplink)
+
+	// Only seen on the port toward another switch: returned, but flagged.
+	loc, err = unifi.MatchMACLocation("bb:bb:bb:bb:bb:02", tables, devices)
+	require.NoError(t, err)
+	require.Equal(t, "core", loc.DeviceName)
+	require.Equal(t, "To office", loc.PortName)
+	require.Equal(t, 20, loc.Vlan)
+	require.True(t, loc.IsUplink)
+
+	// Only seen on a flagged uplink.
+	loc, err = unifi.MatchMACLocation("bb:bb:bb:bb:bb:04", tables, devices)
+	require.NoError(t, err)
+	require.True(t, loc.IsUplink)
+
+	// Without the remote port, the match on the deeper switch still wins.
+	devices.USWs[1].Uplink.UplinkRemotePort = unifi.FlexInt{}
+
+	loc, err = unifi.MatchMACLocation("bb:bb:bb:bb:bb:01", tables, devices)
+	require.NoError(t, err)
+	require.Equal(t, "office", loc.DeviceName)
+
+	_, err = unifi.MatchMACLocation("bb:bb:bb:bb:bb:03", tables, devices)
+	require.ErrorIs(t, err, unifi.ErrMACNotFound)
+
+	_, err = unifi.MatchMACLocation("", tables, devices)
+	require.ErrorIs(t, err, unifi.ErrEmptyMAC)
+}
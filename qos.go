@@ -0,0 +1,204 @@
+package unifi
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+)
+
+// QoS rule objectives reported in QoSRule.Objective.
+const (
+	QoSObjectivePrioritize         = "PRIORITIZE"
+	QoSObjectiveLimit              = "LIMIT"
+	QoSObjectivePrioritizeAndLimit = "PRIORITIZE_AND_LIMIT"
+)
+
+// userGroupDefaultHiddenID marks the built-in group that applies to clients without a usergroup_id.
+const userGroupDefaultHiddenID = "Default"
+
+// UserGroup represents a client bandwidth profile from /api/s/{site}/rest/usergroup.
+// Rates are in Kbps; -1 (or 0 on some firmware) means unlimited.
+type UserGroup struct {
+	ID             string   `fake:"{uuid}"     json:"_id"`
+	AttrHiddenID   string   `json:"attr_hidden_id,omitempty"`
+	AttrNoDelete   FlexBool `json:"attr_no_delete"`
+	Name           string   `fake:"{buzzword}" json:"name"`
+	QOSRateMaxDown FlexInt  `json:"qos_rate_max_down"`
+	QOSRateMaxUp   FlexInt  `json:"qos_rate_max_up"`
+	SiteID         string   `fake:"{uuid}"     json:"site_id"`
+
+	SiteName   string `json:"-"`
+	SourceName string `json:"-"`
+}
+
+// QoSRuleDestination is the port and address match of a QoSRule.
+type QoSRuleDestination struct {
+	IPAddresses []string `json:"ip_addresses,omitempty"`
+	Ports       []string `json:"ports,omitempty"` // single ports or "start-stop" ranges
+	Protocol    string   `json:"protocol,omitempty"`
+}
+
+// QoSRule represents an application or port based QoS rule from /v2/api/site/{site}/qos-rules.
+// Limits are in Kbps and only apply when Objective includes LIMIT.
+type QoSRule struct {
+	ID                string                `fake:"{uuid}"                                                 json:"_id"`
+	AppCategoryIDs    []int                 `json:"app_category_ids"`
+	AppIDs            []int                 `json:"app_ids"`
+	Description       string                `fake:"{buzzword}"                                             json:"description"`
+	Destination       QoSRuleDestination    `json:"destination"`
+	DownloadLimitKbps FlexInt               `json:"download_limit_kbps"`
+	Enabled           FlexBool              `json:"enabled"`
+	Index             FlexInt               `json:"index"`
+	MatchingTarget    string                `fake:"{randomstring:[APP,APP_CATEGORY,PORT,IP]}"              json:"matching_target"`
+	Name              string                `fake:"{buzzword}"                                             json:"name"`
+	Objective         string                `fake:"{randomstring:[PRIORITIZE,LIMIT,PRIORITIZE_AND_LIMIT]}" json:"objective"`
+	Schedule          TrafficRuleSchedule   `json:"schedule"`
+	TargetDevices     []TrafficTargetDevice `json:"target_devices"`
+	UploadLimitKbps   FlexInt               `json:"upload_limit_kbps"`
+
+	SiteName   string `json:"-"`
+	SourceName string `json:"-"`
+}
+
+// ClientQoS describes the bandwidth profile and QoS rules that apply to a client.
+type ClientQoS struct {
+	Client    *Client
+	UserGroup *UserGroup // nil when the client's group was not found
+	QoSRules  []*QoSRule // enabled rules targeting the client, in rule index order
+}
+
+// GetUserGroups returns client bandwidth profiles (user groups) for a single site.
+// Uses the legacy API endpoint: GET /api/s/{site}/rest/usergroup.
+func (u *Unifi) GetUserGroups(site *Site) ([]*UserGroup, error) {
+	if site == nil || site.Name == "" {
+		return nil, ErrNoSiteProvided
+	}
+
+	u.DebugLog("Polling Controller for user groups, site %s", site.SiteName)
+
+	path := fmt.Sprintf(APIUserGroupPath, site.Name)
+
+	var response struct {
+		Data []UserGroup `json:"data"`
+	}
+
+	if err := u.GetData(path, &response); err != nil {
+		return nil, fmt.Errorf("fetching user groups for site %s: %w", site.SiteName, err)
+	}
+
+	result := make([]*UserGroup, len(response.Data))
+
+	for i := range response.Data {
+		response.Data[i].SiteName = site.SiteName
+		response.Data[i].SourceName = u.URL
+		result[i] = &response.Data[i]
+	}
+
+	return result, nil
+}
+
+// GetQoSRules returns QoS rules for a single site.
+// Uses the v2 API endpoint: GET /proxy/network/v2/api/site/{site}/qos-rules
+func (u *Unifi) GetQoSRules(site *Site) ([]*QoSRule, error) {
+	if site == nil || site.Name == "" {
+		return nil, ErrNoSiteProvided
+	}
+
+	u.DebugLog("Polling Controller for QoS rules, site %s", site.SiteName)
+
+	body, err := u.GetJSON(fmt.Sprintf(APIQoSRulesPath, site.Name))
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch QoS rules for site %s: %w", site.SiteName, err)
+	}
+
+	var raw []*QoSRule
+	if err := json.Unmarshal(body, &raw); err != nil {
+		return nil, fmt.Errorf("failed to parse QoS rules for site %s: %w", site.SiteName, err)
+	}
+
+	rules := make([]*QoSRule, 0, len(raw))
+
+	for _, r := range raw {
+		if r == nil {
+			continue
+		}
+
+		r.SiteName = site.SiteName
+		r.SourceName = u.URL
+		rules = append(rules, r)
+	}
+
+	return rules, nil
+}
+
+// Limited reports whether the group caps download or upload bandwidth.
+func (g *UserGroup) Limited() bool {
+	return g.QOSRateMaxDown.Val > 0 || g.QOSRateMaxUp.Val > 0
+}
+
+// Limits reports whether the rule caps bandwidth.
+func (r *QoSRule) Limits() bool {
+	return r.Objective == QoSObjectiveLimit || r.Objective == QoSObjectivePrioritizeAndLimit
+}
+
+// AppliesTo reports whether the rule is enabled and targets the client, either
+// directly by MAC, through the client's network, or as an all-clients rule.
+// A rule without target devices applies to every client.
+func (r *QoSRule) AppliesTo(client *Client) bool {
+	if client == nil || !r.Enabled.Val {
+		return false
+	}
+
+	return targetsInclude(r.TargetDevices, client.Mac, client.NetworkID)
+}
+
+// GetClientQoS fetches the user groups and QoS rules for a site and returns the
+// ones that apply to client. See ResolveClientQoS.
+func (u *Unifi) GetClientQoS(site *Site, client *Client) (*ClientQoS, error) {
+	groups, err := u.GetUserGroups(site)
+	if err != nil {
+		return nil, err
+	}
+
+	rules, err := u.GetQoSRules(site)
+	if err != nil {
+		return nil, err
+	}
+
+	return ResolveClientQoS(client, groups, rules), nil
+}
+
+// ResolveClientQoS returns the user group and QoS rules that apply to client.
+// The group is looked up by Client.UserGroupID; clients without one fall into the
+// built-in default group. Rules are filtered with QoSRule.AppliesTo.
+func ResolveClientQoS(client *Client, groups []*UserGroup, rules []*QoSRule) *ClientQoS {
+	result := &ClientQoS{Client: client, QoSRules: make([]*QoSRule, 0)}
+	if client == nil {
+		return result
+	}
+
+	for _, g := range groups {
+		if g == nil {
+			continue
+		}
+
+		if (client.UserGroupID != "" && g.ID == client.UserGroupID) ||
+			(client.UserGroupID == "" && g.AttrHiddenID == userGroupDefaultHiddenID) {
+			result.UserGroup = g
+
+			break
+		}
+	}
+
+	for _, r := range rules {
+		if r != nil && r.AppliesTo(client) {
+			result.QoSRules = append(result.QoSRules, r)
+		}
+	}
+
+	sort.SliceStable(result.QoSRules, func(i, j int) bool {
+		return result.QoSRules[i].Index.Val < result.QoSRules[j].Index.Val
+	})
+
+	return result
+}
@@ -0,0 +1,64 @@
+package unifi_test
+
+import (
+	"testing"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/stretchr/testify/require"
+	"github.com/unpoller/unifi/v5"
+)
+
+func TestUserGroupStruct(t *testing.T) {
+	t.Parallel()
+
+	var g unifi.UserGroup
+
+	err := gofakeit.Struct(&g)
+	require.NoError(t, err)
+	require.NotEmpty(t, g.ID)
+	require.NotEmpty(t, g.Name)
+}
+
+func TestQoSRuleStruct(t *testing.T) {
+	t.Parallel()
+
+	var r unifi.QoSRule
+
+	err := gofakeit.Struct(&r)
+	require.NoError(t, err)
+	require.NotEmpty(t, r.ID)
+	require.NotEmpty(t, r.Objective)
+}
+
+func TestResolveClientQoS(t *testing.T) {
+	t.Parallel()
+
+	enabled := *unifi.NewFlexBool(true)
+	groups := []*unifi.UserGroup{
+		{ID: "default", AttrHiddenID: "Default"},
+		{ID: "capped", Name: "Capped", QOSRateMaxDown: *unifi.NewFlexInt(5000), QOSRateMaxUp: *unifi.NewFlexInt(-1)},
+		nil,
+	}
+	rules := []*unifi.QoSRule{
+		{ID: "net", Enabled: enabled, Index: *unifi.NewFlexInt(2), TargetDevices: []unifi.TrafficTargetDevice{{Type: "NETWORK", NetworkID: "lan"}}},
+		{ID: "mac", Enabled: enabled, Index: *unifi.NewFlexInt(1), TargetDevices: []unifi.TrafficTargetDevice{{Type: "CLIENT", ClientMac: "AA-BB-CC-DD-EE-FF"}}},
+		{ID: "other", Enabled: enabled, TargetDevices: []unifi.TrafficTargetDevice{{Type: "CLIENT", ClientMac: "11:22:33:44:55:66"}}},
+		{ID: "off", TargetDevices: []unifi.TrafficTargetDevice{{Type: "ALL_CLIENTS"}}},
+		nil,
+	}
+
+	client := &unifi.Client{Mac: "aa:bb:cc:dd:ee:ff", NetworkID: "lan", UserGroupID: "capped"}
+	qos := unifi.ResolveClientQoS(client, groups, rules)
+
+	require.NotNil(t, qos.UserGroup)
+	require.Equal(t, "capped", qos.UserGroup.ID)
+	require.True(t, qos.UserGroup.Limited())
+	require.Len(t, qos.QoSRules, 2)
+	require.Equal(t, "mac", qos.QoSRules[0].ID)
+	require.Equal(t, "net", qos.QoSRules[1].ID)
+
+	qos = unifi.ResolveClientQoS(&unifi.Client{Mac: "00:00:00:00:00:01"}, groups, rules)
+	require.Equal(t, "default", qos.UserGroup.ID)
+	require.False(t, qos.UserGroup.Limited())
+	require.Empty(t, qos.QoSRules)
+}
@@ -0,0 +1,99 @@
+package unifi // nolint: testpackage
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLegacyRADIUSProfileStruct(t *testing.T) {
+	t.Parallel()
+
+	var p LegacyRADIUSProfile
+
+	require.NoError(t, gofakeit.Struct(&p))
+	require.NotEmpty(t, p.ID)
+	require.Len(t, p.AuthServers, 1)
+}
+
+func TestRADIUSUserCRUD(t *testing.T) {
+	t.Parallel()
+
+	type request struct {
+		method, path string
+		body         map[string]any
+	}
+
+	var got []request
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		req := request{method: r.Method, path: r.URL.Path}
+
+		body, _ := io.ReadAll(r.Body)
+		if len(body) > 0 {
+			_ = json.Unmarshal(body, &req.body)
+		}
+
+		got = append(got, req)
+
+		switch r.Method {
+		case http.MethodGet:
+			_, _ = w.Write([]byte(`[{"_id":"u1","name":"sensor-01","vlan":30,"tunnel_type":13,"tunnel_medium_type":6},null]`))
+		case http.MethodPost:
+			w.WriteHeader(http.StatusCreated)
+			_, _ = w.Write([]byte(`{"_id":"u2","name":"sensor-02","vlan":40,"tunnel_type":13,"tunnel_medium_type":6}`))
+		case http.MethodPut:
+			_, _ = w.Write(body)
+		case http.MethodDelete:
+			w.WriteHeader(http.StatusNoContent)
+		}
+	}))
+	defer srv.Close()
+
+	u := &Unifi{
+		Client: &http.Client{},
+		Config: &Config{URL: srv.URL, DebugLog: discardLogs, ErrorLog: discardLogs},
+	}
+	site := &Site{Name: "default", SiteName: "Default"}
+
+	users, err := u.GetRADIUSUsers(site)
+	require.NoError(t, err)
+	require.Len(t, users, 1)
+	assert.Equal(t, 30, users[0].VLAN.Int())
+	assert.Equal(t, "Default", users[0].SiteName)
+
+	created, err := u.CreateRADIUSUser(site, &RADIUSUser{Name: "sensor-02", Password: "secret", VLAN: *NewFlexInt(40)})
+	require.NoError(t, err)
+	assert.Equal(t, "u2", created.ID)
+
+	created.VLAN = *NewFlexInt(50)
+	updated, err := u.UpdateRADIUSUser(site, created)
+	require.NoError(t, err)
+	assert.Equal(t, 50, updated.VLAN.Int())
+
+	require.NoError(t, u.DeleteRADIUSUser(site, "u2"))
+
+	require.Len(t, got, 4)
+	assert.Equal(t, "/proxy/network/v2/api/site/default/radius/users", got[1].path)
+	assert.Equal(t, http.MethodPost, got[1].method)
+	assert.NotContains(t, got[1].body, "_id")
+	assert.EqualValues(t, RADIUSTunnelTypeVLAN, got[1].body["tunnel_type"])
+	assert.EqualValues(t, RADIUSTunnelMediumType802, got[1].body["tunnel_medium_type"])
+	assert.Equal(t, "secret", got[1].body["x_password"])
+	assert.Equal(t, http.MethodPut, got[2].method)
+	assert.Equal(t, "/proxy/network/v2/api/site/default/radius/users/u2", got[2].path)
+	assert.Equal(t, http.MethodDelete, got[3].method)
+	assert.Equal(t, "/proxy/network/v2/api/site/default/radius/users/u2", got[3].path)
+
+	_, err = u.UpdateRADIUSUser(site, &RADIUSUser{Name: "no-id"})
+	require.ErrorIs(t, err, ErrNoRADIUSUserID)
+	_, err = u.CreateRADIUSUser(site, &RADIUSUser{})
+	require.ErrorIs(t, err, ErrNoRADIUSUserName)
+	require.ErrorIs(t, u.DeleteRADIUSUser(site, ""), ErrNoRADIUSUserID)
+}
@@ -0,0 +1,554 @@
+package unifi
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/netip"
+	"sort"
+	"strings"
+)
+
+// ErrNoMatchingRoute is returned by ResolveRoute and MatchRoute when no static or
+// policy route covers the destination; traffic then follows the default WAN route.
+var ErrNoMatchingRoute = errors.New("no static or policy route matches destination")
+
+// Route kinds reported in RouteMatch.Kind.
+const (
+	RouteKindPolicy  = "policy"
+	RouteKindStatic  = "static"
+	RouteKindDefault = "default" // only scoped policy routes match; other traffic uses the default WAN route
+)
+
+// StaticRoute represents a user-defined route from /api/s/{site}/rest/routing.
+type StaticRoute struct {
+	ID                   string   `fake:"{uuid}"                                                   json:"_id"`
+	Enabled              FlexBool `json:"enabled"`
+	GatewayDevice        string   `fake:"{macaddress}"                                             json:"gateway_device"`
+	GatewayType          string   `json:"gateway_type"` // default, switch
+	Name                 string   `fake:"{buzzword}"                                               json:"name"`
+	SiteID               string   `fake:"{uuid}"                                                   json:"site_id"`
+	StaticRouteDistance  FlexInt  `json:"static-route_distance"`
+	StaticRouteInterface string   `json:"static-route_interface"` // WAN name or Network.ID for interface routes
+	StaticRouteNetwork   string   `fake:"{ipv4cidr}"                                               json:"static-route_network"`
+	StaticRouteNexthop   string   `fake:"{ipv4address}"                                            json:"static-route_nexthop"`
+	StaticRouteType      string   `fake:"{randomstring:[nexthop-route,interface-route,blackhole]}" json:"static-route_type"`
+	Type                 string   `json:"type"` // static-route
+
+	SiteName   string `json:"-"`
+	SourceName string `json:"-"`
+}
+
+// TrafficDomain is a domain matcher used by traffic routes and rules.
+type TrafficDomain struct {
+	Domain     string   `fake:"{domainname}" json:"domain"`
+	PortRanges []string `json:"port_ranges"`
+	Ports      []int    `json:"ports"`
+}
+
+// TrafficIPAddress is an IP or subnet matcher used by traffic routes and rules.
+type TrafficIPAddress struct {
+	IPOrSubnet string   `fake:"{ipv4address}" json:"ip_or_subnet"`
+	IPVersion  string   `json:"ip_version"` // v4, v6
+	PortRanges []string `json:"port_ranges"`
+	Ports      []int    `json:"ports"`
+}
+
+// TrafficIPRange is an inclusive IP range matcher used by traffic routes and rules.
+type TrafficIPRange struct {
+	IPStart   string `fake:"{ipv4address}" json:"ip_start"`
+	IPStop    string `fake:"{ipv4address}" json:"ip_stop"`
+	IPVersion string `json:"ip_version"`
+}
+
+// TrafficTargetDevice selects the clients or networks a traffic route or rule applies to.
+type TrafficTargetDevice struct {
+	ClientMac string `fake:"{macaddress}" json:"client_mac,omitempty"`
+	NetworkID string `fake:"{uuid}"       json:"network_id,omitempty"`
+	Type      string `json:"type"` // ALL_CLIENTS, CLIENT, NETWORK
+}
+
+// TrafficRoute represents a policy-based route from /v2/api/site/{site}/trafficroutes.
+// NetworkID references Network.ID of the egress interface (WAN or VPN client network).
+type TrafficRoute struct {
+	ID                    string                `fake:"{uuid}"                                     json:"_id"`
+	Description           string                `fake:"{buzzword}"                                 json:"description"`
+	Domains               []TrafficDomain       `json:"domains"`
+	Enabled               FlexBool              `json:"enabled"`
+	IPAddresses           []TrafficIPAddress    `json:"ip_addresses"`
+	IPRanges              []TrafficIPRange      `json:"ip_ranges"`
+	KillSwitchEnabled     FlexBool              `json:"kill_switch_enabled"`
+	MatchingTarget        string                `fake:"{randomstring:[INTERNET,DOMAIN,IP,REGION]}" json:"matching_target"`
+	NetworkID             string                `fake:"{uuid}"                                     json:"network_id"`
+	NextHop               string                `json:"next_hop"`
+	Regions               []string              `json:"regions"`
+	TargetDevices         []TrafficTargetDevice `json:"target_devices"`
+	TrafficMatchingListID string                `json:"traffic_matching_list_id,omitempty"`
+
+	SiteName   string `json:"-"`
+	SourceName string `json:"-"`
+}
+
+// TrafficRuleBandwidthLimit is the rate limit applied by a SPEED_LIMIT traffic rule.
+type TrafficRuleBandwidthLimit struct {
+	DownloadLimitKbps FlexInt  `json:"download_limit_kbps"`
+	Enabled           FlexBool `json:"enabled"`
+	UploadLimitKbps   FlexInt  `json:"upload_limit_kbps"`
+}
+
+// TrafficRuleSchedule is the schedule during which a traffic rule is active.
+type TrafficRuleSchedule struct {
+	Date           string   `json:"date,omitempty"`
+	Mode           string   `json:"mode"` // ALWAYS, EVERY_DAY, EVERY_WEEK, ONE_TIME_ONLY, CUSTOM
+	RepeatOnDays   []string `json:"repeat_on_days"`
+	TimeAllDay     FlexBool `json:"time_all_day"`
+	TimeRangeEnd   string   `json:"time_range_end,omitempty"`
+	TimeRangeStart string   `json:"time_range_start,omitempty"`
+}
+
+// TrafficRule represents a traffic rule from /v2/api/site/{site}/trafficrules.
+// NetworkIDs reference Network.ID; TrafficMatchingListIDs reference TrafficMatchingList.ID.
+type TrafficRule struct {
+	ID                     string                    `fake:"{uuid}"                                                      json:"_id"`
+	Action                 string                    `fake:"{randomstring:[BLOCK,ALLOW,SPEED_LIMIT]}"                    json:"action"`
+	AppCategoryIDs         []int                     `json:"app_category_ids"`
+	AppIDs                 []int                     `json:"app_ids"`
+	BandwidthLimit         TrafficRuleBandwidthLimit `json:"bandwidth_limit"`
+	Description            string                    `fake:"{buzzword}"                                                  json:"description"`
+	Domains                []TrafficDomain           `json:"domains"`
+	Enabled                FlexBool                  `json:"enabled"`
+	IPAddresses            []TrafficIPAddress        `json:"ip_addresses"`
+	IPRanges               []TrafficIPRange          `json:"ip_ranges"`
+	MatchingTarget         string                    `fake:"{randomstring:[INTERNET,APP,APP_CATEGORY,DOMAIN,IP,REGION]}" json:"matching_target"`
+	NetworkIDs             []string                  `json:"network_ids"`
+	Regions                []string                  `json:"regions"`
+	Schedule               TrafficRuleSchedule       `json:"schedule"`
+	TargetDevices          []TrafficTargetDevice     `json:"target_devices"`
+	TrafficMatchingListIDs []string                  `json:"traffic_matching_list_ids,omitempty"`
+
+	SiteName   string `json:"-"`
+	SourceName string `json:"-"`
+}
+
+// RouteSource identifies where traffic comes from, for picking the policy route
+// that applies to it with RouteMatch.For. ClientMac and NetworkID (Network.ID) are
+// both optional; a zero RouteSource only matches routes that apply to all clients.
+type RouteSource struct {
+	ClientMac string
+	NetworkID string
+}
+
+// RouteMatch describes the route selected by MatchRoute or ResolveRoute for traffic
+// from any client. StaticRoute or TrafficRoute is set according to Kind; neither is
+// set for RouteKindDefault. Scoped lists the policy routes limited to specific clients
+// or networks that also match the destination, most specific first; use For to pick
+// the route for a given source.
+type RouteMatch struct {
+	Kind         string // RouteKindPolicy, RouteKindStatic or RouteKindDefault
+	Destination  string // the destination that was looked up
+	Prefix       string // the matching subnet, range or "0.0.0.0/0" for INTERNET policy routes
+	StaticRoute  *StaticRoute
+	TrafficRoute *TrafficRoute
+	Scoped       []*RouteMatch
+
+	rank int // prefix length for IP matches, 0 for ranges and -1 for INTERNET
+}
+
+// GetStaticRoutes returns user-defined static routes for a single site.
+// Uses the legacy API endpoint: GET /api/s/{site}/rest/routing.
+func (u *Unifi) GetStaticRoutes(site *Site) ([]*StaticRoute, error) {
+	if site == nil || site.Name == "" {
+		return nil, ErrNoSiteProvided
+	}
+
+	u.DebugLog("Polling Controller for static routes, site %s", site.SiteName)
+
+	path := fmt.Sprintf(APIStaticRoutePath, site.Name)
+
+	var response struct {
+		Data []StaticRoute `json:"data"`
+	}
+
+	if err := u.GetData(path, &response); err != nil {
+		return nil, fmt.Errorf("fetching static routes for site %s: %w", site.SiteName, err)
+	}
+
+	result := make([]*StaticRoute, len(response.Data))
+
+	for i := range response.Data {
+		response.Data[i].SiteName = site.SiteName
+		response.Data[i].SourceName = u.URL
+		result[i] = &response.Data[i]
+	}
+
+	return result, nil
+}
+
+// GetTrafficRoutes returns policy-based traffic routes for a single site.
+// Uses the v2 API endpoint: GET /proxy/network/v2/api/site/{site}/trafficroutes
+func (u *Unifi) GetTrafficRoutes(site *Site) ([]*TrafficRoute, error) {
+	if site == nil || site.Name == "" {
+		return nil, ErrNoSiteProvided
+	}
+
+	u.DebugLog("Polling Controller for traffic routes, site %s", site.SiteName)
+
+	body, err := u.GetJSON(fmt.Sprintf(APITrafficRoutesPath, site.Name))
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch traffic routes for site %s: %w", site.SiteName, err)
+	}
+
+	var raw []*TrafficRoute
+	if err := json.Unmarshal(body, &raw); err != nil {
+		return nil, fmt.Errorf("failed to parse traffic routes for site %s: %w", site.SiteName, err)
+	}
+
+	routes := make([]*TrafficRoute, 0, len(raw))
+
+	for _, r := range raw {
+		if r == nil {
+			continue
+		}
+
+		r.SiteName = site.SiteName
+		r.SourceName = u.URL
+		routes = append(routes, r)
+	}
+
+	return routes, nil
+}
+
+// GetTrafficRules returns traffic rules for a single site.
+// Uses the v2 API endpoint: GET /proxy/network/v2/api/site/{site}/trafficrules
+func (u *Unifi) GetTrafficRules(site *Site) ([]*TrafficRule, error) {
+	if site == nil || site.Name == "" {
+		return nil, ErrNoSiteProvided
+	}
+
+	u.DebugLog("Polling Controller for traffic rules, site %s", site.SiteName)
+
+	body, err := u.GetJSON(fmt.Sprintf(APITrafficRulesPath, site.Name))
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch traffic rules for site %s: %w", site.SiteName, err)
+	}
+
+	var raw []*TrafficRule
+	if err := json.Unmarshal(body, &raw); err != nil {
+		return nil, fmt.Errorf("failed to parse traffic rules for site %s: %w", site.SiteName, err)
+	}
+
+	rules := make([]*TrafficRule, 0, len(raw))
+
+	for _, r := range raw {
+		if r == nil {
+			continue
+		}
+
+		r.SiteName = site.SiteName
+		r.SourceName = u.URL
+		rules = append(rules, r)
+	}
+
+	return rules, nil
+}
+
+// TargetNetwork returns the egress network this route sends traffic through, or nil if not found.
+func (r *TrafficRoute) TargetNetwork(networks []Network) *Network {
+	return findNetwork(networks, r.NetworkID)
+}
+
+// Networks returns the networks this rule applies to. Unknown IDs are skipped.
+func (r *TrafficRule) Networks(networks []Network) []*Network {
+	result := make([]*Network, 0, len(r.NetworkIDs))
+
+	for _, id := range r.NetworkIDs {
+		if n := findNetwork(networks, id); n != nil {
+			result = append(result, n)
+		}
+	}
+
+	return result
+}
+
+// MatchingLists returns the traffic matching lists this rule references. Unknown IDs are skipped.
+func (r *TrafficRule) MatchingLists(lists []*TrafficMatchingList) []*TrafficMatchingList {
+	result := make([]*TrafficMatchingList, 0, len(r.TrafficMatchingListIDs))
+
+	for _, id := range r.TrafficMatchingListIDs {
+		for _, l := range lists {
+			if l != nil && l.ID == id {
+				result = append(result, l)
+
+				break
+			}
+		}
+	}
+
+	return result
+}
+
+// InterfaceNetwork returns the network an interface route points at, or nil when
+// the route is not an interface route or targets a WAN.
+func (r *StaticRoute) InterfaceNetwork(networks []Network) *Network {
+	return findNetwork(networks, r.StaticRouteInterface)
+}
+
+// MatchingList returns the traffic matching list this route references, or nil if not found.
+func (r *TrafficRoute) MatchingList(lists []*TrafficMatchingList) *TrafficMatchingList {
+	if r.TrafficMatchingListID == "" {
+		return nil
+	}
+
+	for _, l := range lists {
+		if l != nil && l.ID == r.TrafficMatchingListID {
+			return l
+		}
+	}
+
+	return nil
+}
+
+// AppliesTo reports whether the route's target devices include src. A route
+// without target devices applies to every client.
+func (r *TrafficRoute) AppliesTo(src RouteSource) bool {
+	return targetsInclude(r.TargetDevices, src.ClientMac, src.NetworkID)
+}
+
+// For returns the route that carries traffic from src: the most specific scoped
+// policy route that applies to src when it beats the unscoped match, otherwise m.
+func (m *RouteMatch) For(src RouteSource) *RouteMatch {
+	if m == nil {
+		return nil
+	}
+
+	best := m
+	if m.Kind != RouteKindPolicy {
+		best = nil
+	}
+
+	for _, s := range m.Scoped {
+		if s.TrafficRoute.AppliesTo(src) && (best == nil || s.rank > best.rank) {
+			best = s
+		}
+	}
+
+	if best == nil {
+		return m
+	}
+
+	return best
+}
+
+// ResolveRoute fetches the static and policy routes for a site and returns the one
+// that would carry traffic to dstIP. See MatchRoute for the selection rules.
+func (u *Unifi) ResolveRoute(site *Site, dstIP string) (*RouteMatch, error) {
+	static, err := u.GetStaticRoutes(site)
+	if err != nil {
+		return nil, err
+	}
+
+	policy, err := u.GetTrafficRoutes(site)
+	if err != nil {
+		return nil, err
+	}
+
+	return MatchRoute(dstIP, static, policy)
+}
+
+// MatchRoute returns the route that would carry traffic to dstIP. Enabled policy
+// routes are evaluated first, since the gateway applies them before the main
+// routing table: IP routes match on their addresses and ranges, INTERNET routes
+// match any non-private destination. Otherwise the enabled static route with the
+// longest matching prefix wins, with the lowest distance breaking ties.
+//
+// Policy routes limited to specific clients or networks are returned in
+// RouteMatch.Scoped rather than selected; call For with the traffic source to
+// apply them. Policy routes that match on domains, regions or a traffic matching
+// list (TrafficMatchingListID) cannot be evaluated from an IP alone and are
+// skipped. Returns ErrNoMatchingRoute when nothing matches.
+func MatchRoute(dstIP string, static []*StaticRoute, policy []*TrafficRoute) (*RouteMatch, error) {
+	dst, err := netip.ParseAddr(strings.TrimSpace(dstIP))
+	if err != nil {
+		return nil, fmt.Errorf("parsing destination %q: %w", dstIP, err)
+	}
+
+	var (
+		match  *RouteMatch
+		scoped []*RouteMatch
+	)
+
+	for _, r := range policy {
+		m := matchPolicyRoute(dst, r)
+
+		switch {
+		case m == nil:
+		case !r.AppliesTo(RouteSource{}):
+			scoped = append(scoped, m)
+		case match == nil || m.rank > match.rank:
+			match = m
+		}
+	}
+
+	if match == nil {
+		match = matchStaticRoute(dst, static)
+	}
+
+	if match == nil && len(scoped) == 0 {
+		return nil, fmt.Errorf("%s: %w", dst, ErrNoMatchingRoute)
+	}
+
+	if match == nil {
+		match = &RouteMatch{Kind: RouteKindDefault, Destination: dst.String()}
+	}
+
+	sort.SliceStable(scoped, func(i, j int) bool { return scoped[i].rank > scoped[j].rank })
+	match.Scoped = scoped
+
+	return match, nil
+}
+
+// matchPolicyRoute returns the most specific match of an enabled policy route for dst, or nil.
+func matchPolicyRoute(dst netip.Addr, r *TrafficRoute) *RouteMatch {
+	if r == nil || !r.Enabled.Val {
+		return nil
+	}
+
+	var best *RouteMatch
+
+	switch r.MatchingTarget {
+	case "IP":
+		for _, a := range r.IPAddresses {
+			if bits, ok := prefixContains(a.IPOrSubnet, dst); ok && (best == nil || bits > best.rank) {
+				best = newPolicyMatch(dst, a.IPOrSubnet, r, bits)
+			}
+		}
+
+		for _, rng := range r.IPRanges {
+			if best == nil && rangeContains(rng.IPStart, rng.IPStop, dst) {
+				best = newPolicyMatch(dst, rng.IPStart+"-"+rng.IPStop, r, 0)
+			}
+		}
+	case "INTERNET":
+		if !dst.IsPrivate() && !dst.IsLoopback() && !dst.IsLinkLocalUnicast() {
+			prefix := "0.0.0.0/0"
+			if dst.Is6() {
+				prefix = "::/0"
+			}
+
+			best = newPolicyMatch(dst, prefix, r, -1)
+		}
+	}
+
+	return best
+}
+
+func newPolicyMatch(dst netip.Addr, prefix string, r *TrafficRoute, rank int) *RouteMatch {
+	return &RouteMatch{Kind: RouteKindPolicy, Destination: dst.String(), Prefix: prefix, TrafficRoute: r, rank: rank}
+}
+
+func matchStaticRoute(dst netip.Addr, static []*StaticRoute) *RouteMatch {
+	var (
+		best     *StaticRoute
+		bestBits = -1
+	)
+
+	for _, r := range static {
+		if r == nil || !r.Enabled.Val {
+			continue
+		}
+
+		bits, ok := prefixContains(r.StaticRouteNetwork, dst)
+		if !ok {
+			continue
+		}
+
+		if bits > bestBits || (bits == bestBits && r.StaticRouteDistance.Val < best.StaticRouteDistance.Val) {
+			best, bestBits = r, bits
+		}
+	}
+
+	if best == nil {
+		return nil
+	}
+
+	return &RouteMatch{Kind: RouteKindStatic, Destination: dst.String(), Prefix: best.StaticRouteNetwork, StaticRoute: best}
+}
+
+// prefixContains reports whether spec (an address or CIDR) contains dst, and the prefix length.
+func prefixContains(spec string, dst netip.Addr) (int, bool) {
+	spec = strings.TrimSpace(spec)
+	if spec == "" {
+		return 0, false
+	}
+
+	if !strings.Contains(spec, "/") {
+		addr, err := netip.ParseAddr(spec)
+		if err != nil || addr != dst {
+			return 0, false
+		}
+
+		return addr.BitLen(), true
+	}
+
+	prefix, err := netip.ParsePrefix(spec)
+	if err != nil || !prefix.Contains(dst) {
+		return 0, false
+	}
+
+	return prefix.Bits(), true
+}
+
+// rangeContains reports whether dst is within the inclusive range start-stop.
+func rangeContains(start, stop string, dst netip.Addr) bool {
+	from, err := netip.ParseAddr(start)
+	if err != nil {
+		return false
+	}
+
+	to, err := netip.ParseAddr(stop)
+	if err != nil {
+		return false
+	}
+
+	return from.Compare(dst) <= 0 && dst.Compare(to) <= 0
+}
+
+// targetsInclude reports whether target devices select the client with mac or
+// the network with networkID. An empty target list selects every client.
+func targetsInclude(targets []TrafficTargetDevice, mac, networkID string) bool {
+	if len(targets) == 0 {
+		return true
+	}
+
+	for _, t := range targets {
+		switch t.Type {
+		case "ALL_CLIENTS":
+			return true
+		case "CLIENT":
+			if t.ClientMac != "" && mac != "" && normalizeMAC(t.ClientMac) == normalizeMAC(mac) {
+				return true
+			}
+		case "NETWORK":
+			if t.NetworkID != "" && t.NetworkID == networkID {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// findNetwork returns the network with the given ID, or nil.
+func findNetwork(networks []Network, id string) *Network {
+	if id == "" {
+		return nil
+	}
+
+	for i := range networks {
+		if networks[i].ID == id {
+			return &networks[i]
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,156 @@
+package unifi_test
+
+import (
+	"testing"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/unpoller/unifi/v5"
+)
+
+func TestStaticRouteStruct(t *testing.T) {
+	t.Parallel()
+
+	var r unifi.StaticRoute
+
+	err := gofakeit.Struct(&r)
+	require.NoError(t, err)
+	require.NotEmpty(t, r.ID)
+	require.NotEmpty(t, r.StaticRouteNetwork)
+}
+
+func TestTrafficRouteStruct(t *testing.T) {
+	t.Parallel()
+
+	var r unifi.TrafficRoute
+
+	err := gofakeit.Struct(&r)
+	require.NoError(t, err)
+	require.NotEmpty(t, r.ID)
+}
+
+func TestTrafficRuleStruct(t *testing.T) {
+	t.Parallel()
+
+	var r unifi.TrafficRule
+
+	err := gofakeit.Struct(&r)
+	require.NoError(t, err)
+	require.NotEmpty(t, r.ID)
+}
+
+func TestMatchRoute(t *testing.T) {
+	t.Parallel()
+
+	enabled := *unifi.NewFlexBool(true)
+	static := []*unifi.StaticRoute{
+		{ID: "wide", Enabled: enabled, StaticRouteNetwork: "10.0.0.0/8", StaticRouteDistance: *unifi.NewFlexInt(1)},
+		{ID: "narrow-far", Enabled: enabled, StaticRouteNetwork: "10.20.0.0/16", StaticRouteDistance: *unifi.NewFlexInt(10)},
+		{ID: "narrow-near", Enabled: enabled, StaticRouteNetwork: "10.20.0.0/16", StaticRouteDistance: *unifi.NewFlexInt(5)},
+		{ID: "disabled", StaticRouteNetwork: "10.20.30.0/24"},
+	}
+	policy := []*unifi.TrafficRoute{
+		{ID: "vpn-ip", Enabled: enabled, MatchingTarget: "IP", IPAddresses: []unifi.TrafficIPAddress{{IPOrSubnet: "10.20.30.0/24"}}},
+		{ID: "vpn-range", Enabled: enabled, MatchingTarget: "IP", IPRanges: []unifi.TrafficIPRange{{IPStart: "172.16.0.10", IPStop: "172.16.0.20"}}},
+		{ID: "vpn-internet", Enabled: enabled, MatchingTarget: "INTERNET"},
+		{ID: "domain", Enabled: enabled, MatchingTarget: "DOMAIN"},
+	}
+
+	a := assert.New(t)
+
+	m, err := unifi.MatchRoute("10.20.30.40", static, policy)
+	require.NoError(t, err)
+	a.Equal(unifi.RouteKindPolicy, m.Kind)
+	a.Equal("vpn-ip", m.TrafficRoute.ID)
+
+	m, err = unifi.MatchRoute("10.20.1.1", static, policy)
+	require.NoError(t, err)
+	a.Equal(unifi.RouteKindStatic, m.Kind)
+	a.Equal("narrow-near", m.StaticRoute.ID)
+
+	m, err = unifi.MatchRoute("10.1.1.1", static, policy)
+	require.NoError(t, err)
+	a.Equal("wide", m.StaticRoute.ID)
+
+	m, err = unifi.MatchRoute("172.16.0.15", static, policy)
+	require.NoError(t, err)
+	a.Equal("vpn-range", m.TrafficRoute.ID)
+
+	m, err = unifi.MatchRoute("8.8.8.8", static, policy)
+	require.NoError(t, err)
+	a.Equal("vpn-internet", m.TrafficRoute.ID)
+	a.Equal("0.0.0.0/0", m.Prefix)
+
+	_, err = unifi.MatchRoute("192.168.1.1", static, policy)
+	require.ErrorIs(t, err, unifi.ErrNoMatchingRoute)
+
+	_, err = unifi.MatchRoute("not-an-ip", static, policy)
+	require.Error(t, err)
+}
+
+func TestMatchRouteScoped(t *testing.T) {
+	t.Parallel()
+
+	enabled := *unifi.NewFlexBool(true)
+	policy := []*unifi.TrafficRoute{
+		{
+			ID: "laptop-vpn", Enabled: enabled, MatchingTarget: "INTERNET",
+			TargetDevices: []unifi.TrafficTargetDevice{{Type: "CLIENT", ClientMac: "AA-BB-CC-DD-EE-FF"}},
+		},
+		{
+			ID: "iot-vpn", Enabled: enabled, MatchingTarget: "IP",
+			IPAddresses:   []unifi.TrafficIPAddress{{IPOrSubnet: "203.0.113.0/24"}},
+			TargetDevices: []unifi.TrafficTargetDevice{{Type: "NETWORK", NetworkID: "iot"}},
+		},
+		{
+			ID: "listed", Enabled: enabled, MatchingTarget: "IP", TrafficMatchingListID: "list-1",
+		},
+	}
+	static := []*unifi.StaticRoute{{ID: "default", Enabled: enabled, StaticRouteNetwork: "0.0.0.0/0"}}
+
+	a := assert.New(t)
+
+	m, err := unifi.MatchRoute("203.0.113.9", static, policy)
+	require.NoError(t, err)
+	a.Equal("default", m.StaticRoute.ID, "scoped routes are not selected for all clients")
+	require.Len(t, m.Scoped, 2)
+	a.Equal("iot-vpn", m.Scoped[0].TrafficRoute.ID, "most specific first")
+	a.Equal("laptop-vpn", m.Scoped[1].TrafficRoute.ID)
+
+	a.Equal("iot-vpn", m.For(unifi.RouteSource{NetworkID: "iot"}).TrafficRoute.ID)
+	a.Equal("laptop-vpn", m.For(unifi.RouteSource{ClientMac: "aa:bb:cc:dd:ee:ff"}).TrafficRoute.ID)
+	a.Same(m, m.For(unifi.RouteSource{ClientMac: "11:22:33:44:55:66"}), "route scoped to another client must not apply")
+	a.Same(m, m.For(unifi.RouteSource{}))
+
+	// Without a static route, scoped matches are still reported.
+	m, err = unifi.MatchRoute("8.8.8.8", nil, policy)
+	require.NoError(t, err)
+	a.Equal(unifi.RouteKindDefault, m.Kind)
+	a.Nil(m.StaticRoute)
+	a.Nil(m.TrafficRoute)
+	require.Len(t, m.Scoped, 1)
+	a.Equal("laptop-vpn", m.For(unifi.RouteSource{ClientMac: "aa:bb:cc:dd:ee:ff"}).TrafficRoute.ID)
+
+	_, err = unifi.MatchRoute("192.168.1.1", nil, policy)
+	require.ErrorIs(t, err, unifi.ErrNoMatchingRoute, "matching-list routes are skipped")
+}
+
+func TestTrafficRuleCrossReferences(t *testing.T) {
+	t.Parallel()
+
+	networks := []unifi.Network{{ID: "n1", Name: "LAN"}, {ID: "n2", Name: "IoT"}}
+	lists := []*unifi.TrafficMatchingList{{ID: "l1", Name: "Blocked"}}
+	rule := &unifi.TrafficRule{NetworkIDs: []string{"n2", "gone"}, TrafficMatchingListIDs: []string{"l1"}}
+	route := &unifi.TrafficRoute{NetworkID: "n1", TrafficMatchingListID: "l1"}
+
+	a := assert.New(t)
+	require.Len(t, rule.Networks(networks), 1)
+	a.Equal("IoT", rule.Networks(networks)[0].Name)
+	require.Len(t, rule.MatchingLists(lists), 1)
+	a.Equal("Blocked", rule.MatchingLists(lists)[0].Name)
+	a.Equal("LAN", route.TargetNetwork(networks).Name)
+	a.Equal("Blocked", route.MatchingList(lists).Name)
+	a.Nil((&unifi.TrafficRoute{TrafficMatchingListID: "gone"}).MatchingList(lists))
+	a.Nil((&unifi.StaticRoute{StaticRouteInterface: "WAN1"}).InterfaceNetwork(networks))
+}
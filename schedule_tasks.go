@@ -0,0 +1,59 @@
+package unifi
+
+import "fmt"
+
+// Scheduled task actions reported in ScheduleTask.Action.
+const (
+	ScheduleTaskUpgrade   = "upgrade"
+	ScheduleTaskSpeedTest = "speedtest"
+)
+
+// ScheduleTaskUpgradeTarget is a device included in a scheduled firmware upgrade.
+type ScheduleTaskUpgradeTarget struct {
+	Mac string `fake:"{macaddress}" json:"mac"`
+}
+
+// ScheduleTask represents a scheduled task from /api/s/{site}/rest/scheduletask.
+// CronExpr uses five-field cron syntax in the controller's timezone.
+type ScheduleTask struct {
+	ID              string                      `fake:"{uuid}"                             json:"_id"`
+	Action          string                      `fake:"{randomstring:[upgrade,speedtest]}" json:"action"`
+	CronExpr        string                      `json:"cron_expr"`
+	ExecuteOnlyOnce FlexBool                    `json:"execute_only_once"`
+	Name            string                      `fake:"{buzzword}"                         json:"name"`
+	SiteID          string                      `fake:"{uuid}"                             json:"site_id"`
+	UpgradeTargets  []ScheduleTaskUpgradeTarget `fakesize:"2"                              json:"upgrade_targets,omitempty"`
+
+	SiteName   string `json:"-"`
+	SourceName string `json:"-"`
+}
+
+// GetScheduleTasks returns scheduled tasks, such as firmware upgrades and speed tests, for a single site.
+// Uses the legacy API endpoint: GET /api/s/{site}/rest/scheduletask.
+func (u *Unifi) GetScheduleTasks(site *Site) ([]*ScheduleTask, error) {
+	if site == nil || site.Name == "" {
+		return nil, ErrNoSiteProvided
+	}
+
+	u.DebugLog("Polling Controller for scheduled tasks, site %s", site.SiteName)
+
+	path := fmt.Sprintf(APIScheduleTaskPath, site.Name)
+
+	var response struct {
+		Data []ScheduleTask `json:"data"`
+	}
+
+	if err := u.GetData(path, &response); err != nil {
+		return nil, fmt.Errorf("fetching scheduled tasks for site %s: %w", site.SiteName, err)
+	}
+
+	result := make([]*ScheduleTask, len(response.Data))
+
+	for i := range response.Data {
+		response.Data[i].SiteName = site.SiteName
+		response.Data[i].SourceName = u.URL
+		result[i] = &response.Data[i]
+	}
+
+	return result, nil
+}
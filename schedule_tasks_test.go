@@ -0,0 +1,19 @@
+package unifi_test
+
+import (
+	"testing"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/stretchr/testify/require"
+	"github.com/unpoller/unifi/v5"
+)
+
+func TestScheduleTaskStruct(t *testing.T) {
+	t.Parallel()
+
+	var s unifi.ScheduleTask
+
+	require.NoError(t, gofakeit.Struct(&s))
+	require.NotEmpty(t, s.ID)
+	require.Len(t, s.UpgradeTargets, 2)
+}
@@ -123,62 +123,70 @@ type Site struct {
 	AttrHiddenID string   `json:"attr_hidden_id"`
 	AttrNoDelete FlexBool `json:"attr_no_delete"`
 	controller   *Unifi
-	Desc         string `fake:"{buzzword}" json:"desc"`
-	Health       []struct {
-		Drops         FlexInt  `json:"drops,omitempty"`
-		Gateways      []string `fakesize:"5"             json:"gateways,omitempty"`
-		GwMac         string   `fake:"{macaddress}"      json:"gw_mac,omitempty"`
-		GwName        string   `json:"gw_name,omitempty"`
-		GwSystemStats struct {
-			CPU    FlexInt `json:"cpu"`
-			Mem    FlexInt `json:"mem"`
-			Uptime FlexInt `json:"uptime"`
-		} `json:"gw_system-stats,omitempty"`
-		GwVersion             string   `fake:"{appversion}"                        json:"gw_version,omitempty"`
-		LanIP                 string   `json:"lan_ip,omitempty"`
-		Latency               FlexInt  `json:"latency,omitempty"`
-		Nameservers           []string `fakesize:"5"                               json:"nameservers,omitempty"`
-		Netmask               string   `json:"netmask,omitempty"`
-		NumAdopted            FlexInt  `json:"num_adopted,omitempty"`
-		NumAp                 FlexInt  `json:"num_ap,omitempty"`
-		NumDisabled           FlexInt  `json:"num_disabled,omitempty"`
-		NumDisconnected       FlexInt  `json:"num_disconnected,omitempty"`
-		NumGuest              FlexInt  `json:"num_guest,omitempty"`
-		NumGw                 FlexInt  `json:"num_gw,omitempty"`
-		NumIot                FlexInt  `json:"num_iot,omitempty"`
-		NumPending            FlexInt  `json:"num_pending,omitempty"`
-		NumSta                FlexInt  `json:"num_sta,omitempty"`
-		NumSw                 FlexInt  `json:"num_sw,omitempty"`
-		NumUser               FlexInt  `json:"num_user,omitempty"`
-		RemoteUserEnabled     FlexBool `json:"remote_user_enabled,omitempty"`
-		RemoteUserNumActive   FlexInt  `json:"remote_user_num_active,omitempty"`
-		RemoteUserNumInactive FlexInt  `json:"remote_user_num_inactive,omitempty"`
-		RemoteUserRxBytes     FlexInt  `json:"remote_user_rx_bytes,omitempty"`
-		RemoteUserRxPackets   FlexInt  `json:"remote_user_rx_packets,omitempty"`
-		RemoteUserTxBytes     FlexInt  `json:"remote_user_tx_bytes,omitempty"`
-		RemoteUserTxPackets   FlexInt  `json:"remote_user_tx_packets,omitempty"`
-		RxBytesR              FlexInt  `json:"rx_bytes-r,omitempty"`
-		SiteToSiteEnabled     FlexBool `json:"site_to_site_enabled,omitempty"`
-		SiteToSiteNumActive   FlexInt  `json:"site_to_site_num_active,omitempty"`
-		SiteToSiteNumInactive FlexInt  `json:"site_to_site_num_inactive,omitempty"`
-		SiteToSiteRxBytes     FlexInt  `json:"site_to_site_rx_bytes,omitempty"`
-		SiteToSiteRxPackets   FlexInt  `json:"site_to_site_rx_packets,omitempty"`
-		SiteToSiteTxBytes     FlexInt  `json:"site_to_site_tx_bytes,omitempty"`
-		SiteToSiteTxPackets   FlexInt  `json:"site_to_site_tx_packets,omitempty"`
-		SpeedtestLastrun      FlexInt  `json:"speedtest_lastrun,omitempty"`
-		SpeedtestPing         FlexInt  `json:"speedtest_ping,omitempty"`
-		SpeedtestStatus       string   `json:"speedtest_status,omitempty"`
-		Status                string   `json:"status"`
-		Subsystem             string   `json:"subsystem"`
-		TxBytesR              FlexInt  `json:"tx_bytes-r,omitempty"`
-		Uptime                FlexInt  `json:"uptime,omitempty"`
-		WanIP                 string   `fake:"{ipv4address}"                       json:"wan_ip,omitempty"`
-		XputDown              FlexInt  `json:"xput_down,omitempty"`
-		XputUp                FlexInt  `json:"xput_up,omitempty"`
-	} `fakesize:"5"                          json:"health"`
-	ID           string  `fake:"{uuid}"                         json:"_id"`
-	Name         string  `fake:"{randomstring:[site-1,site-2]}" json:"name"`
-	NumNewAlarms FlexInt `json:"num_new_alarms"`
-	SiteName     string  `json:"-"`
-	SourceName   string  `json:"-"`
+	Desc         string            `fake:"{buzzword}"                     json:"desc"`
+	Health       []SubsystemHealth `fakesize:"5"                          json:"health"`
+	ID           string            `fake:"{uuid}"                         json:"_id"`
+	Name         string            `fake:"{randomstring:[site-1,site-2]}" json:"name"`
+	NumNewAlarms FlexInt           `json:"num_new_alarms"`
+	SiteName     string            `json:"-"`
+	SourceName   string            `json:"-"`
+}
+
+// SubsystemHealth represents the health of one site subsystem (wan, www, lan, wlan, vpn).
+// It is reported in Site.Health and returned by GetSiteHealth. Only the fields relevant
+// to the subsystem are populated; Status is "ok", "warning", "error" or "unknown".
+type SubsystemHealth struct {
+	Drops         FlexInt  `json:"drops,omitempty"`
+	Gateways      []string `fakesize:"5"             json:"gateways,omitempty"`
+	GwMac         string   `fake:"{macaddress}"      json:"gw_mac,omitempty"`
+	GwName        string   `json:"gw_name,omitempty"`
+	GwSystemStats struct {
+		CPU    FlexInt `json:"cpu"`
+		Mem    FlexInt `json:"mem"`
+		Uptime FlexInt `json:"uptime"`
+	} `json:"gw_system-stats,omitempty"`
+	GwVersion             string   `fake:"{appversion}"                        json:"gw_version,omitempty"`
+	LanIP                 string   `json:"lan_ip,omitempty"`
+	Latency               FlexInt  `json:"latency,omitempty"`
+	Nameservers           []string `fakesize:"5"                               json:"nameservers,omitempty"`
+	Netmask               string   `json:"netmask,omitempty"`
+	NumAdopted            FlexInt  `json:"num_adopted,omitempty"`
+	NumAp                 FlexInt  `json:"num_ap,omitempty"`
+	NumDisabled           FlexInt  `json:"num_disabled,omitempty"`
+	NumDisconnected       FlexInt  `json:"num_disconnected,omitempty"`
+	NumGuest              FlexInt  `json:"num_guest,omitempty"`
+	NumGw                 FlexInt  `json:"num_gw,omitempty"`
+	NumIot                FlexInt  `json:"num_iot,omitempty"`
+	NumPending            FlexInt  `json:"num_pending,omitempty"`
+	NumSta                FlexInt  `json:"num_sta,omitempty"`
+	NumSw                 FlexInt  `json:"num_sw,omitempty"`
+	NumUser               FlexInt  `json:"num_user,omitempty"`
+	RemoteUserEnabled     FlexBool `json:"remote_user_enabled,omitempty"`
+	RemoteUserNumActive   FlexInt  `json:"remote_user_num_active,omitempty"`
+	RemoteUserNumInactive FlexInt  `json:"remote_user_num_inactive,omitempty"`
+	RemoteUserRxBytes     FlexInt  `json:"remote_user_rx_bytes,omitempty"`
+	RemoteUserRxPackets   FlexInt  `json:"remote_user_rx_packets,omitempty"`
+	RemoteUserTxBytes     FlexInt  `json:"remote_user_tx_bytes,omitempty"`
+	RemoteUserTxPackets   FlexInt  `json:"remote_user_tx_packets,omitempty"`
+	RxBytesR              FlexInt  `json:"rx_bytes-r,omitempty"`
+	SiteToSiteEnabled     FlexBool `json:"site_to_site_enabled,omitempty"`
+	SiteToSiteNumActive   FlexInt  `json:"site_to_site_num_active,omitempty"`
+	SiteToSiteNumInactive FlexInt  `json:"site_to_site_num_inactive,omitempty"`
+	SiteToSiteRxBytes     FlexInt  `json:"site_to_site_rx_bytes,omitempty"`
+	SiteToSiteRxPackets   FlexInt  `json:"site_to_site_rx_packets,omitempty"`
+	SiteToSiteTxBytes     FlexInt  `json:"site_to_site_tx_bytes,omitempty"`
+	SiteToSiteTxPackets   FlexInt  `json:"site_to_site_tx_packets,omitempty"`
+	SpeedtestLastrun      FlexInt  `json:"speedtest_lastrun,omitempty"`
+	SpeedtestPing         FlexInt  `json:"speedtest_ping,omitempty"`
+	SpeedtestStatus       string   `json:"speedtest_status,omitempty"`
+	Status                string   `json:"status"`
+	Subsystem             string   `json:"subsystem"`
+	TxBytesR              FlexInt  `json:"tx_bytes-r,omitempty"`
+	Uptime                FlexInt  `json:"uptime,omitempty"`
+	WanIP                 string   `fake:"{ipv4address}"                       json:"wan_ip,omitempty"`
+	XputDown              FlexInt  `json:"xput_down,omitempty"`
+	XputUp                FlexInt  `json:"xput_up,omitempty"`
+
+	SiteName   string `json:"-"`
+	SourceName string `json:"-"`
 }
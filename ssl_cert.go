@@ -1,6 +1,9 @@
 package unifi
 
-import "fmt"
+import (
+	"fmt"
+	"time"
+)
 
 // SSLCertificate represents the active SSL certificate from /api/s/{site}/stat/active.
 type SSLCertificate struct {
@@ -70,3 +73,29 @@ func (u *Unifi) GetSSLCertificate(site *Site) (*SSLCertificate, error) {
 
 	return &response.Data[0], nil
 }
+
+// ExpiresAt returns the earliest expiry of the certificate and its chain.
+// Returns the zero time when no expiry is known.
+func (c *SSLCertificate) ExpiresAt() time.Time {
+	earliest := c.ValidTo.Int64()
+
+	for i := range c.Chain {
+		if v := c.Chain[i].ValidTo.Int64(); v > 0 && (earliest <= 0 || v < earliest) {
+			earliest = v
+		}
+	}
+
+	if earliest <= 0 {
+		return time.Time{}
+	}
+
+	return time.Unix(earliest, 0)
+}
+
+// ExpiresWithin reports whether the certificate, or any certificate in its chain,
+// expires before now plus d. Already-expired certificates return true.
+func (c *SSLCertificate) ExpiresWithin(now time.Time, d time.Duration) bool {
+	expires := c.ExpiresAt()
+
+	return !expires.IsZero() && expires.Before(now.Add(d))
+}
@@ -2,6 +2,7 @@ package unifi_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/brianvoe/gofakeit/v6"
 	"github.com/stretchr/testify/require"
@@ -35,3 +36,19 @@ func TestNetworkExtendedFields(t *testing.T) {
 	err := gofakeit.Struct(&n)
 	require.NoError(t, err)
 }
+
+func TestSSLCertificateExpiresWithin(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	cert := &unifi.SSLCertificate{ValidTo: *unifi.NewFlexInt(float64(now.Add(365 * 24 * time.Hour).Unix()))}
+	require.False(t, cert.ExpiresWithin(now, 30*24*time.Hour))
+
+	// An intermediate expiring soon makes the whole chain expire soon.
+	cert.Chain = []unifi.SSLCertificateChain{{ValidTo: *unifi.NewFlexInt(float64(now.Add(7 * 24 * time.Hour).Unix()))}}
+	require.True(t, cert.ExpiresWithin(now, 30*24*time.Hour))
+	require.Equal(t, cert.Chain[0].ValidTo.Int64(), cert.ExpiresAt().Unix())
+
+	require.True(t, (&unifi.SSLCertificate{}).ExpiresAt().IsZero())
+	require.False(t, (&unifi.SSLCertificate{}).ExpiresWithin(now, time.Hour))
+}
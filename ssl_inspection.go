@@ -0,0 +1,107 @@
+package unifi
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// SSLInspectionSetting represents the SSL inspection setting from /v2/api/site/{site}/ssl-inspection/setting.
+// CertificateID references the SSLCertificate.ID used as the inspection CA.
+type SSLInspectionSetting struct {
+	ID            string   `fake:"{uuid}"                      json:"_id"`
+	CertificateID string   `fake:"{uuid}"                      json:"certificate_id"`
+	Enabled       FlexBool `json:"enabled"`
+	ExcludedHosts []string `json:"excluded_domains"`
+	Mode          string   `fake:"{randomstring:[OFF,SIMPLE]}" json:"mode"`
+	NetworkIDs    []string `json:"network_ids"`
+
+	SiteName   string `json:"-"`
+	SourceName string `json:"-"`
+}
+
+// GetSSLInspectionSetting returns the SSL inspection setting for a single site.
+// Uses the v2 API endpoint: GET /proxy/network/v2/api/site/{site}/ssl-inspection/setting
+func (u *Unifi) GetSSLInspectionSetting(site *Site) (*SSLInspectionSetting, error) {
+	if site == nil || site.Name == "" {
+		return nil, ErrNoSiteProvided
+	}
+
+	u.DebugLog("Polling Controller for SSL inspection setting, site %s", site.SiteName)
+
+	body, err := u.GetJSON(fmt.Sprintf(APISSLInspectionSettingPath, site.Name))
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch SSL inspection setting for site %s: %w", site.SiteName, err)
+	}
+
+	var setting SSLInspectionSetting
+	if err := json.Unmarshal(body, &setting); err != nil {
+		return nil, fmt.Errorf("failed to parse SSL inspection setting for site %s: %w", site.SiteName, err)
+	}
+
+	setting.SiteName = site.SiteName
+	setting.SourceName = u.URL
+
+	return &setting, nil
+}
+
+// GetSSLInspectionCertificates returns the certificates available for SSL inspection on a single site.
+// Uses the v2 API endpoint: GET /proxy/network/v2/api/site/{site}/certificates
+func (u *Unifi) GetSSLInspectionCertificates(site *Site) ([]*SSLCertificate, error) {
+	if site == nil || site.Name == "" {
+		return nil, ErrNoSiteProvided
+	}
+
+	u.DebugLog("Polling Controller for SSL inspection certificates, site %s", site.SiteName)
+
+	body, err := u.GetJSON(fmt.Sprintf(APISSLInspectionCertificatesPath, site.Name))
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch SSL inspection certificates for site %s: %w", site.SiteName, err)
+	}
+
+	var raw []*SSLCertificate
+	if err := json.Unmarshal(body, &raw); err != nil {
+		return nil, fmt.Errorf("failed to parse SSL inspection certificates for site %s: %w", site.SiteName, err)
+	}
+
+	certs := make([]*SSLCertificate, 0, len(raw))
+
+	for _, c := range raw {
+		if c == nil {
+			continue
+		}
+
+		c.SiteName = site.SiteName
+		certs = append(certs, c)
+	}
+
+	return certs, nil
+}
+
+// GetActiveSSLInspectionCertificate returns the certificate currently used as the SSL inspection CA.
+// As with GetSSLCertificate, a zero-value SSLCertificate with only SiteName set is returned
+// when the controller has no active certificate; check cert.ID == "".
+// Uses the v2 API endpoint: GET /proxy/network/v2/api/site/{site}/certificates/active
+func (u *Unifi) GetActiveSSLInspectionCertificate(site *Site) (*SSLCertificate, error) {
+	if site == nil || site.Name == "" {
+		return nil, ErrNoSiteProvided
+	}
+
+	u.DebugLog("Polling Controller for active SSL inspection certificate, site %s", site.SiteName)
+
+	body, err := u.GetJSON(fmt.Sprintf(APISSLInspectionActiveCertificatePath, site.Name))
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch active SSL inspection certificate for site %s: %w", site.SiteName, err)
+	}
+
+	var cert SSLCertificate
+
+	if len(body) > 0 && string(body) != "null" {
+		if err := json.Unmarshal(body, &cert); err != nil {
+			return nil, fmt.Errorf("failed to parse active SSL inspection certificate for site %s: %w", site.SiteName, err)
+		}
+	}
+
+	cert.SiteName = site.SiteName
+
+	return &cert, nil
+}
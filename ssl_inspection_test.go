@@ -0,0 +1,19 @@
+package unifi_test
+
+import (
+	"testing"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/stretchr/testify/require"
+	"github.com/unpoller/unifi/v5"
+)
+
+func TestSSLInspectionSettingStruct(t *testing.T) {
+	t.Parallel()
+
+	var s unifi.SSLInspectionSetting
+
+	err := gofakeit.Struct(&s)
+	require.NoError(t, err)
+	require.NotEmpty(t, s.CertificateID)
+}
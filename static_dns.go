@@ -0,0 +1,181 @@
+package unifi
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/netip"
+	"strings"
+)
+
+// StaticDNSRecord represents a local DNS record served by the gateway, from
+// /v2/api/site/{site}/static-dns or /static-dns/devices. Key is the hostname and
+// Value the target: an IP address for A/AAAA records, a hostname for CNAME/MX/SRV.
+// Device records are generated from client and device names and carry their MAC.
+type StaticDNSRecord struct {
+	ID         string   `fake:"{uuid}"                  json:"_id"`
+	Enabled    FlexBool `json:"enabled"`
+	Key        string   `fake:"{domainname}"            json:"key"`
+	MAC        string   `json:"mac,omitempty"`
+	Port       FlexInt  `json:"port,omitempty"`
+	Priority   FlexInt  `json:"priority,omitempty"`
+	RecordType string   `fake:"{randomstring:[A,AAAA]}" json:"record_type"`
+	TTL        FlexInt  `json:"ttl,omitempty"`
+	Value      string   `fake:"{ipv4address}"           json:"value"`
+	Weight     FlexInt  `json:"weight,omitempty"`
+
+	SiteName   string `json:"-"`
+	SourceName string `json:"-"`
+}
+
+// DHCPOption represents a custom DHCP option definition from /api/s/{site}/rest/dhcpoption.
+type DHCPOption struct {
+	ID     string   `fake:"{uuid}"                                json:"_id"`
+	Code   FlexInt  `json:"code"`
+	Name   string   `fake:"{buzzword}"                            json:"name"`
+	Signed FlexBool `json:"signed"`
+	SiteID string   `fake:"{uuid}"                                json:"site_id"`
+	Type   string   `fake:"{randomstring:[text,ipaddress,int32]}" json:"type"`
+	Width  FlexInt  `json:"width"`
+
+	SiteName   string `json:"-"`
+	SourceName string `json:"-"`
+}
+
+// GetStaticDNSRecords returns user-defined static DNS records for a single site.
+// Uses the v2 API endpoint: GET /proxy/network/v2/api/site/{site}/static-dns
+func (u *Unifi) GetStaticDNSRecords(site *Site) ([]*StaticDNSRecord, error) {
+	return u.getStaticDNS(site, APIStaticDNSPath, "static DNS records")
+}
+
+// GetStaticDNSDeviceRecords returns the DNS records generated for clients and devices on a single site.
+// Uses the v2 API endpoint: GET /proxy/network/v2/api/site/{site}/static-dns/devices
+func (u *Unifi) GetStaticDNSDeviceRecords(site *Site) ([]*StaticDNSRecord, error) {
+	return u.getStaticDNS(site, APIStaticDNSDevicesPath, "static DNS device records")
+}
+
+func (u *Unifi) getStaticDNS(site *Site, apiPath, what string) ([]*StaticDNSRecord, error) {
+	if site == nil || site.Name == "" {
+		return nil, ErrNoSiteProvided
+	}
+
+	u.DebugLog("Polling Controller for %s, site %s", what, site.SiteName)
+
+	body, err := u.GetJSON(fmt.Sprintf(apiPath, site.Name))
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch %s for site %s: %w", what, site.SiteName, err)
+	}
+
+	var raw []*StaticDNSRecord
+	if err := json.Unmarshal(body, &raw); err != nil {
+		return nil, fmt.Errorf("failed to parse %s for site %s: %w", what, site.SiteName, err)
+	}
+
+	records := make([]*StaticDNSRecord, 0, len(raw))
+
+	for _, r := range raw {
+		if r == nil {
+			continue
+		}
+
+		r.SiteName = site.SiteName
+		r.SourceName = u.URL
+		records = append(records, r)
+	}
+
+	return records, nil
+}
+
+// GetDHCPOptions returns custom DHCP option definitions for a single site.
+// Uses the legacy API endpoint: GET /api/s/{site}/rest/dhcpoption.
+func (u *Unifi) GetDHCPOptions(site *Site) ([]*DHCPOption, error) {
+	if site == nil || site.Name == "" {
+		return nil, ErrNoSiteProvided
+	}
+
+	u.DebugLog("Polling Controller for DHCP options, site %s", site.SiteName)
+
+	path := fmt.Sprintf(APIDHCPOptionPath, site.Name)
+
+	var response struct {
+		Data []DHCPOption `json:"data"`
+	}
+
+	if err := u.GetData(path, &response); err != nil {
+		return nil, fmt.Errorf("fetching DHCP options for site %s: %w", site.SiteName, err)
+	}
+
+	result := make([]*DHCPOption, len(response.Data))
+
+	for i := range response.Data {
+		response.Data[i].SiteName = site.SiteName
+		response.Data[i].SourceName = u.URL
+		result[i] = &response.Data[i]
+	}
+
+	return result, nil
+}
+
+// GetStaleDNSRecords fetches the static DNS records, active DHCP leases and clients
+// for a site and returns the records pointing at unheld IPs. See FindStaleDNSRecords.
+func (u *Unifi) GetStaleDNSRecords(site *Site) ([]*StaticDNSRecord, error) {
+	records, err := u.GetStaticDNSRecords(site)
+	if err != nil {
+		return nil, err
+	}
+
+	leases, err := u.GetActiveDHCPLeases([]*Site{site})
+	if err != nil {
+		return nil, err
+	}
+
+	clients, err := u.GetClients([]*Site{site})
+	if err != nil {
+		return nil, err
+	}
+
+	return FindStaleDNSRecords(records, leases, clients), nil
+}
+
+// FindStaleDNSRecords returns the enabled A and AAAA records whose IP address is not
+// held by any DHCP lease or connected client (current or fixed IP). Records of other
+// types, and records whose value is not an IP address, are never reported.
+func FindStaleDNSRecords(records []*StaticDNSRecord, leases []*DHCPLease, clients []*Client) []*StaticDNSRecord {
+	held := make(map[netip.Addr]struct{}, len(leases)+len(clients))
+	hold := func(ip string) {
+		if addr, err := netip.ParseAddr(strings.TrimSpace(ip)); err == nil {
+			held[addr.Unmap()] = struct{}{}
+		}
+	}
+
+	for _, l := range leases {
+		if l != nil {
+			hold(l.IP)
+		}
+	}
+
+	for _, c := range clients {
+		if c != nil {
+			hold(c.IP)
+			hold(c.FixedIP)
+		}
+	}
+
+	stale := make([]*StaticDNSRecord, 0)
+
+	for _, r := range records {
+		if r == nil || !r.Enabled.Val || (r.RecordType != "A" && r.RecordType != "AAAA") {
+			continue
+		}
+
+		addr, err := netip.ParseAddr(strings.TrimSpace(r.Value))
+		if err != nil {
+			continue
+		}
+
+		if _, ok := held[addr.Unmap()]; !ok {
+			stale = append(stale, r)
+		}
+	}
+
+	return stale
+}
@@ -0,0 +1,58 @@
+package unifi_test
+
+import (
+	"testing"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/stretchr/testify/require"
+	"github.com/unpoller/unifi/v5"
+)
+
+func TestStaticDNSRecordStruct(t *testing.T) {
+	t.Parallel()
+
+	var r unifi.StaticDNSRecord
+
+	err := gofakeit.Struct(&r)
+	require.NoError(t, err)
+	require.NotEmpty(t, r.ID)
+	require.NotEmpty(t, r.Value)
+}
+
+func TestDHCPOptionStruct(t *testing.T) {
+	t.Parallel()
+
+	var o unifi.DHCPOption
+
+	err := gofakeit.Struct(&o)
+	require.NoError(t, err)
+	require.NotEmpty(t, o.ID)
+	require.NotEmpty(t, o.Type)
+}
+
+func TestFindStaleDNSRecords(t *testing.T) {
+	t.Parallel()
+
+	enabled := *unifi.NewFlexBool(true)
+	records := []*unifi.StaticDNSRecord{
+		{ID: "lease", Enabled: enabled, RecordType: "A", Value: "10.0.0.10"},
+		{ID: "client", Enabled: enabled, RecordType: "A", Value: "10.0.0.20"},
+		{ID: "fixed", Enabled: enabled, RecordType: "A", Value: "10.0.0.30"},
+		{ID: "stale", Enabled: enabled, RecordType: "A", Value: "10.0.0.99"},
+		{ID: "stale6", Enabled: enabled, RecordType: "AAAA", Value: "fd00::99"},
+		{ID: "disabled", RecordType: "A", Value: "10.0.0.98"},
+		{ID: "cname", Enabled: enabled, RecordType: "CNAME", Value: "nas.lan"},
+		nil,
+	}
+	leases := []*unifi.DHCPLease{{IP: "10.0.0.10"}, nil}
+	clients := []*unifi.Client{{IP: "10.0.0.20"}, {IP: "10.0.0.31", FixedIP: "10.0.0.30"}, nil}
+
+	stale := unifi.FindStaleDNSRecords(records, leases, clients)
+	ids := make([]string, 0, len(stale))
+
+	for _, r := range stale {
+		ids = append(ids, r.ID)
+	}
+
+	require.Equal(t, []string{"stale", "stale6"}, ids)
+}
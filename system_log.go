@@ -2,14 +2,20 @@ package unifi
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"regexp"
+	"slices"
 	"sort"
+	"strings"
 	"time"
 )
 
 var placeholderRegex = regexp.MustCompile(`\{(\w+)\}`)
 
+// ErrInvalidSystemLogFilter is wrapped by SystemLogFilterError.
+var ErrInvalidSystemLogFilter = errors.New("invalid system log filter")
+
 // SystemLogEntry represents a v2 system log event from the UniFi Controller.
 // API Path: /v2/api/site/{site}/system-log/all
 type SystemLogEntry struct {
@@ -63,6 +69,35 @@ type SystemLogRequest struct {
 	PageSize      int      `json:"pageSize"`
 }
 
+// SystemLogFilterData lists the filter values a controller accepts in a SystemLogRequest.
+// Valid categories changed in Network 10.x, so requests should be checked against it.
+// API Path: /v2/api/site/{site}/system-log/filter-data
+type SystemLogFilterData struct {
+	Categories    []string `json:"categories"`
+	Subcategories []string `json:"subcategories"`
+	Severities    []string `json:"severities"`
+	Events        []string `json:"events"`
+}
+
+// SystemLogFilterError is returned when a SystemLogRequest uses filter values the
+// controller does not accept; the controller would otherwise return no events.
+type SystemLogFilterError struct {
+	Field   string // categories, subcategories, severities or events
+	Invalid []string
+	Valid   []string
+}
+
+// Error lists the rejected values and the values the controller accepts.
+func (e *SystemLogFilterError) Error() string {
+	return fmt.Sprintf("%v: unknown %s %s; valid values: %s", ErrInvalidSystemLogFilter,
+		e.Field, strings.Join(e.Invalid, ", "), strings.Join(e.Valid, ", "))
+}
+
+// Unwrap allows errors.Is(err, ErrInvalidSystemLogFilter).
+func (e *SystemLogFilterError) Unwrap() error {
+	return ErrInvalidSystemLogFilter
+}
+
 // SystemLogResponse represents the response from the system log API.
 type SystemLogResponse struct {
 	Data              []*SystemLogEntry `json:"data"`
@@ -111,6 +146,126 @@ func (u *Unifi) GetSystemLog(sites []*Site, req *SystemLogRequest) ([]*SystemLog
 
 // GetSiteSystemLog retrieves system log events from a single site using the v2 API.
 func (u *Unifi) GetSiteSystemLog(site *Site, req *SystemLogRequest) ([]*SystemLogEntry, error) {
+	return u.getSystemLogPages(site, APISystemLogPath, "System Log", req)
+}
+
+// GetCriticalSystemLog retrieves critical system log events from a single site using the v2 API.
+func (u *Unifi) GetCriticalSystemLog(site *Site, req *SystemLogRequest) ([]*SystemLogEntry, error) {
+	return u.getSystemLogPages(site, APISystemLogCriticalPath, "critical System Log", req)
+}
+
+// GetSystemLogCount returns the number of system log events matching req on a single site.
+func (u *Unifi) GetSystemLogCount(site *Site, req *SystemLogRequest) (int, error) {
+	if site == nil || site.Name == "" {
+		return 0, ErrNoSiteProvided
+	}
+
+	if req == nil {
+		req = DefaultSystemLogRequest(time.Hour)
+	}
+
+	if err := u.validateSystemLogRequest(site, req); err != nil {
+		return 0, err
+	}
+
+	u.DebugLog("Polling Controller for System Log count (v2), site %s", site.SiteName)
+
+	reqJSON, err := json.Marshal(req)
+	if err != nil {
+		return 0, fmt.Errorf("marshaling system log request: %w", err)
+	}
+
+	var response struct {
+		Count int `json:"count"`
+	}
+
+	if err := u.GetData(fmt.Sprintf(APISystemLogCountPath, site.Name), &response, string(reqJSON)); err != nil {
+		return 0, fmt.Errorf("fetching system log count for site %s: %w", site.SiteName, err)
+	}
+
+	return response.Count, nil
+}
+
+// systemLogFilterRetry is how long a failed filter-data request is remembered
+// before GetSystemLogFilterData asks the controller again.
+const systemLogFilterRetry = 5 * time.Minute
+
+// systemLogFilterEntry is a cached filter-data result. A zero retryAt never expires.
+type systemLogFilterEntry struct {
+	filter  *SystemLogFilterData
+	err     error
+	retryAt time.Time
+}
+
+// GetSystemLogFilterData returns the categories, subcategories, severities and events
+// the controller accepts in a SystemLogRequest. Results are cached per site for the
+// life of the client; valid values only change with a controller upgrade. Controllers
+// without the endpoint (ErrEndpointNotFound) are cached too, so they are asked once,
+// and other failures are returned again for a few minutes before the next attempt.
+// Call InvalidateSystemLogFilterData to fetch again, e.g. after an upgrade.
+func (u *Unifi) GetSystemLogFilterData(site *Site) (*SystemLogFilterData, error) {
+	if site == nil || site.Name == "" {
+		return nil, ErrNoSiteProvided
+	}
+
+	u.systemLogFiltersMu.Lock()
+	entry, ok := u.systemLogFilters[site.Name]
+	u.systemLogFiltersMu.Unlock()
+
+	if ok && (entry.retryAt.IsZero() || time.Now().Before(entry.retryAt)) {
+		return entry.filter, entry.err
+	}
+
+	u.DebugLog("Polling Controller for System Log filter data (v2), site %s", site.SiteName)
+
+	entry = &systemLogFilterEntry{filter: &SystemLogFilterData{}}
+
+	err := u.GetData(fmt.Sprintf(APISystemLogFilterDataPath, site.Name), entry.filter, "{}")
+	if err != nil {
+		entry.filter = nil
+		entry.err = fmt.Errorf("fetching system log filter data for site %s: %w", site.SiteName, err)
+
+		if !errors.Is(err, ErrEndpointNotFound) {
+			entry.retryAt = time.Now().Add(systemLogFilterRetry)
+		}
+	}
+
+	u.systemLogFiltersMu.Lock()
+	defer u.systemLogFiltersMu.Unlock()
+
+	if u.systemLogFilters == nil {
+		u.systemLogFilters = make(map[string]*systemLogFilterEntry)
+	}
+
+	u.systemLogFilters[site.Name] = entry
+
+	return entry.filter, entry.err
+}
+
+// InvalidateSystemLogFilterData drops the cached filter data for every site,
+// so the next GetSystemLogFilterData call asks the controller again.
+func (u *Unifi) InvalidateSystemLogFilterData() {
+	u.systemLogFiltersMu.Lock()
+	defer u.systemLogFiltersMu.Unlock()
+
+	u.systemLogFilters = nil
+}
+
+// validateSystemLogRequest checks req against the site's filter data. Controllers
+// without the filter-data endpoint skip validation.
+func (u *Unifi) validateSystemLogRequest(site *Site, req *SystemLogRequest) error {
+	filter, err := u.GetSystemLogFilterData(site)
+	if err != nil {
+		u.DebugLog("Skipping system log request validation: %v", err)
+
+		return nil
+	}
+
+	return req.Validate(filter)
+}
+
+// getSystemLogPages posts req to a paginated system log path and returns every page, sorted by timestamp.
+func (u *Unifi) getSystemLogPages(site *Site, apiPath, name string, req *SystemLogRequest) ([]*SystemLogEntry, error) {
 	if site == nil || site.Name == "" {
 		return nil, ErrNoSiteProvided
 	}
@@ -119,7 +274,11 @@ func (u *Unifi) GetSiteSystemLog(site *Site, req *SystemLogRequest) ([]*SystemLo
 		req = DefaultSystemLogRequest(time.Hour)
 	}
 
-	u.DebugLog("Polling Controller for System Log (v2), site %s", site.SiteName)
+	if err := u.validateSystemLogRequest(site, req); err != nil {
+		return nil, err
+	}
+
+	u.DebugLog("Polling Controller for %s (v2), site %s", name, site.SiteName)
 
 	var allEntries []*SystemLogEntry
 
@@ -130,7 +289,7 @@ func (u *Unifi) GetSiteSystemLog(site *Site, req *SystemLogRequest) ([]*SystemLo
 		reqCopy := *req
 		reqCopy.PageNumber = currentPage
 
-		path := fmt.Sprintf(APISystemLogPath, site.Name)
+		path := fmt.Sprintf(apiPath, site.Name)
 
 		// Marshal the request to JSON
 		reqJSON, err := json.Marshal(reqCopy)
@@ -172,6 +331,46 @@ func (u *Unifi) GetSiteSystemLog(site *Site, req *SystemLogRequest) ([]*SystemLo
 	return allEntries, nil
 }
 
+// Validate checks the request's categories, subcategories, severities and events
+// against filter and returns a *SystemLogFilterError for the first field with
+// unknown values. Fields the controller did not report in filter are not checked.
+func (r *SystemLogRequest) Validate(filter *SystemLogFilterData) error {
+	if r == nil || filter == nil {
+		return nil
+	}
+
+	checks := []struct {
+		field     string
+		requested []string
+		valid     []string
+	}{
+		{"categories", r.Categories, filter.Categories},
+		{"subcategories", r.Subcategories, filter.Subcategories},
+		{"severities", r.Severities, filter.Severities},
+		{"events", r.Events, filter.Events},
+	}
+
+	for _, c := range checks {
+		if len(c.valid) == 0 {
+			continue
+		}
+
+		var invalid []string
+
+		for _, v := range c.requested {
+			if !slices.Contains(c.valid, v) {
+				invalid = append(invalid, v)
+			}
+		}
+
+		if len(invalid) > 0 {
+			return &SystemLogFilterError{Field: c.field, Invalid: invalid, Valid: c.valid}
+		}
+	}
+
+	return nil
+}
+
 // Datetime returns the timestamp as a time.Time for compatibility with Loki.
 func (s *SystemLogEntry) Datetime() time.Time {
 	return time.UnixMilli(s.Timestamp)
@@ -0,0 +1,163 @@
+package unifi // nolint: testpackage
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSystemLogRequestValidate(t *testing.T) {
+	t.Parallel()
+
+	filter := &SystemLogFilterData{
+		Categories: []string{"SECURITY", "UNIFI_DEVICES", "INTERNET_AND_WAN"},
+		Severities: []string{"LOW", "MEDIUM", "HIGH", "VERY_HIGH"},
+	}
+
+	req := &SystemLogRequest{Categories: []string{"SECURITY"}, Severities: []string{"HIGH"}, Events: []string{"ANY"}}
+	require.NoError(t, req.Validate(filter), "events are not reported in filter and must not be checked")
+
+	req.Categories = []string{"SECURITY", "MONITORING", "SYSTEM"}
+
+	err := req.Validate(filter)
+	require.ErrorIs(t, err, ErrInvalidSystemLogFilter)
+
+	var filterErr *SystemLogFilterError
+
+	require.True(t, errors.As(err, &filterErr))
+	assert.Equal(t, "categories", filterErr.Field)
+	assert.Equal(t, []string{"MONITORING", "SYSTEM"}, filterErr.Invalid)
+	assert.Contains(t, err.Error(), "SECURITY, UNIFI_DEVICES, INTERNET_AND_WAN")
+}
+
+func TestGetSystemLogCount(t *testing.T) {
+	t.Parallel()
+
+	var filterCalls, countCalls atomic.Int32
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/v2/api/site/default/system-log/filter-data":
+			filterCalls.Add(1)
+			_, _ = w.Write([]byte(`{"categories":["SECURITY","AUDIT"],"severities":["LOW","HIGH"]}`))
+		case "/v2/api/site/default/system-log/count":
+			countCalls.Add(1)
+			_, _ = w.Write([]byte(`{"count":42}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer srv.Close()
+
+	u := &Unifi{
+		Client: &http.Client{},
+		Config: &Config{URL: srv.URL, DebugLog: discardLogs, ErrorLog: discardLogs},
+	}
+	site := &Site{Name: "default"}
+
+	count, err := u.GetSystemLogCount(site, &SystemLogRequest{Categories: []string{"AUDIT"}})
+	require.NoError(t, err)
+	assert.Equal(t, 42, count)
+
+	_, err = u.GetSystemLogCount(site, &SystemLogRequest{Categories: []string{"POWER"}})
+	require.ErrorIs(t, err, ErrInvalidSystemLogFilter)
+
+	assert.EqualValues(t, 1, filterCalls.Load(), "filter data should be cached")
+	assert.EqualValues(t, 1, countCalls.Load(), "invalid requests should not be sent")
+}
+
+func TestGetSystemLogFilterDataUnavailable(t *testing.T) {
+	t.Parallel()
+
+	var filterCalls atomic.Int32
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/v2/api/site/default/system-log/filter-data":
+			filterCalls.Add(1)
+			w.WriteHeader(http.StatusNotFound)
+		case "/v2/api/site/default/system-log/count":
+			_, _ = w.Write([]byte(`{"count":7}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer srv.Close()
+
+	u := &Unifi{
+		Client: &http.Client{},
+		Config: &Config{URL: srv.URL, DebugLog: discardLogs, ErrorLog: discardLogs},
+	}
+	site := &Site{Name: "default"}
+
+	for range 2 {
+		count, err := u.GetSystemLogCount(site, &SystemLogRequest{Categories: []string{"ANYTHING"}})
+		require.NoError(t, err, "validation is skipped without filter data")
+		assert.Equal(t, 7, count)
+	}
+
+	_, err := u.GetSystemLogFilterData(site)
+	require.ErrorIs(t, err, ErrEndpointNotFound)
+	assert.EqualValues(t, 1, filterCalls.Load(), "a missing endpoint should be cached")
+
+	u.InvalidateSystemLogFilterData()
+
+	_, err = u.GetSystemLogFilterData(site)
+	require.ErrorIs(t, err, ErrEndpointNotFound)
+	assert.EqualValues(t, 2, filterCalls.Load(), "invalidate should fetch again")
+}
+
+func TestGetSystemLogFilterDataBackoff(t *testing.T) {
+	t.Parallel()
+
+	var filterCalls atomic.Int32
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/v2/api/site/default/system-log/filter-data":
+			if filterCalls.Add(1) == 1 {
+				w.WriteHeader(http.StatusBadGateway)
+
+				return
+			}
+
+			_, _ = w.Write([]byte(`{"categories":["SECURITY"]}`))
+		case "/v2/api/site/default/system-log/count":
+			_, _ = w.Write([]byte(`{"count":3}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer srv.Close()
+
+	u := &Unifi{
+		Client: &http.Client{},
+		Config: &Config{URL: srv.URL, DebugLog: discardLogs, ErrorLog: discardLogs},
+	}
+	site := &Site{Name: "default"}
+
+	for range 3 {
+		count, err := u.GetSystemLogCount(site, &SystemLogRequest{Categories: []string{"AUDIT"}})
+		require.NoError(t, err, "validation is skipped while filter data is failing")
+		assert.Equal(t, 3, count)
+	}
+
+	_, err := u.GetSystemLogFilterData(site)
+	require.ErrorIs(t, err, ErrInvalidStatusCode)
+	assert.EqualValues(t, 1, filterCalls.Load(), "a failed request should not be repeated right away")
+
+	u.systemLogFiltersMu.Lock()
+	u.systemLogFilters[site.Name].retryAt = time.Now().Add(-time.Second)
+	u.systemLogFiltersMu.Unlock()
+
+	filter, err := u.GetSystemLogFilterData(site)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"SECURITY"}, filter.Categories)
+	assert.EqualValues(t, 2, filterCalls.Load())
+}
@@ -63,7 +63,8 @@ type TopologyEdge struct {
 	Protocol           string  `json:"protocol"`  // ax, ac, n, g
 	RadioBand          string  `json:"radioBand"` // ng, na, 6e
 	RateMbps           FlexInt `json:"rateMbps"`
-	Type               string  `json:"type"` // WIRED or WIRELESS
+	Signal             FlexInt `json:"signal"` // dBm, WIRELESS edges only
+	Type               string  `json:"type"`   // WIRED or WIRELESS
 	UplinkMac          string  `json:"uplinkMac"`
 	UplinkPortNumber   FlexInt `json:"uplinkPortNumber"`
 }
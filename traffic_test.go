@@ -20,3 +20,20 @@ func TestUsageByCountry(test *testing.T) {
 	err := gofakeit.Struct(&t)
 	require.NoError(test, err)
 }
+
+func TestAppTrafficRate(test *testing.T) {
+	t := unifi.AppTrafficRate{}
+	err := gofakeit.Struct(&t)
+	require.NoError(test, err)
+	require.NotEmpty(test, t.Rates)
+}
+
+func TestAppTrafficRateResolveName(test *testing.T) {
+	t := unifi.AppTrafficRate{Category: 0, Application: 1}
+	require.Equal(test, "MSN", t.Name())
+	require.Equal(test, "Instant Messengers", t.CategoryName())
+
+	catalogue := []*unifi.DPIApplication{{ID: *unifi.NewFlexInt(1), Name: "Messenger"}}
+	require.Equal(test, "Messenger", t.ResolveName(catalogue))
+	require.Equal(test, "MSN", t.ResolveName(nil))
+}
@@ -167,7 +167,14 @@ const (
 	APIClientTrafficPath      string = "/v2/api/site/%s/traffic?start=%d&end=%d&includeUnidentified=%t"
 	APIClientTrafficByMacPath string = "/v2/api/site/%s/traffic/%s?start=%d&end=%d&includeUnidentified=%t&mac=%s"
 	APICountryTrafficPath     string = "/v2/api/site/%s/country-traffic?start=%d&end=%d"
+	APIAppTrafficRatePath     string = "/v2/api/site/%s/app-traffic-rate"
 	APIAggregatedDashboard    string = "/v2/api/site/%s/aggregated-dashboard?historySeconds=%d"
+	// APISystemLogCountPath returns the number of system log events matching a request (v2 API).
+	APISystemLogCountPath string = "/v2/api/site/%s/system-log/count"
+	// APISystemLogCriticalPath returns critical system log events (v2 API).
+	APISystemLogCriticalPath string = "/v2/api/site/%s/system-log/critical"
+	// APISystemLogFilterDataPath returns the valid system log filter values (v2 API).
+	APISystemLogFilterDataPath string = "/v2/api/site/%s/system-log/filter-data"
 	// APIProtectLogPath returns Protect system log events.
 	APIProtectLogPath string = "/proxy/protect/api/events/system-logs"
 	// APIProtectEventsPath is the base path for Protect events (for thumbnails, etc.).
@@ -178,6 +185,14 @@ const (
 	APIActiveDHCPLeasesPath string = "/proxy/network/v2/api/site/%s/active-leases"
 	// APIWANEnrichedConfigPath returns enriched WAN configuration with statistics.
 	APIWANEnrichedConfigPath string = "/proxy/network/v2/api/site/%s/wan/enriched-configuration"
+	// APILANEnrichedConfigPath returns enriched LAN configuration with statistics.
+	APILANEnrichedConfigPath string = "/proxy/network/v2/api/site/%s/lan/enriched-configuration"
+	// APILANMDNSPath returns the gateway mDNS reflector settings.
+	APILANMDNSPath string = "/proxy/network/v2/api/site/%s/lan/mdns"
+	// APIGlobalNetworkConfigPath returns site-wide network settings.
+	APIGlobalNetworkConfigPath string = "/proxy/network/v2/api/site/%s/global/config/network"
+	// APIExcludedIPsPath returns addresses and ranges excluded from DHCP pools.
+	APIExcludedIPsPath string = "/proxy/network/v2/api/site/%s/excluded-ips/"
 	// APIWANISPStatusPath returns WAN interface status (ACTIVE/BACKUP).
 	APIWANISPStatusPath string = "/proxy/network/v2/api/site/%s/wan/%s/isp-status"
 	// APIWANLoadBalancingStatusPath returns load balancing status for WAN interfaces.
@@ -188,12 +203,70 @@ const (
 	APIFirewallPoliciesPath string = "/proxy/network/v2/api/site/%s/firewall-policies"
 	// APIWANSLAsPath returns WAN SLA monitoring data (latency, packet loss, jitter).
 	APIWANSLAsPath string = "/proxy/network/v2/api/site/%s/wan-slas"
+	// APIWANNetworkGroupsPath returns per-WAN port information (uptime, priority).
+	APIWANNetworkGroupsPath string = "/proxy/network/v2/api/site/%s/wan/networkgroups"
+	// APIWANMagicConfigurationPath returns WAN data usage cap settings.
+	APIWANMagicConfigurationPath string = "/proxy/network/v2/api/site/%s/wan/magic/configuration"
+	// APIWANMagicSubscriptionPath returns metered WAN subscription usage.
+	APIWANMagicSubscriptionPath string = "/proxy/network/v2/api/site/%s/wan/magic/subscription"
 	// APITopologyPath returns network topology data (vertices and edges) for a site.
 	APITopologyPath string = "/proxy/network/v2/api/site/%s/topology"
+	// APIAPGroupsPath returns access point groups for a site.
+	APIAPGroupsPath string = "/proxy/network/v2/api/site/%s/apgroups"
+	// APIWirelessLinksPath returns mesh and point-to-point wireless uplinks for a site.
+	APIWirelessLinksPath string = "/proxy/network/v2/api/site/%s/device/wireless-links"
 	// APIPortAnomaliesPath returns port anomaly data for a site.
 	APIPortAnomaliesPath string = "/proxy/network/v2/api/site/%s/ports/port-anomalies"
+	// APIPortMACTablesPath returns learned MAC addresses per switch port (POST with device MACs).
+	APIPortMACTablesPath string = "/proxy/network/v2/api/site/%s/ports/mac-tables"
 	// APIMagicSiteToSiteVPNPath returns Site Magic site-to-site VPN mesh configurations for a site.
 	APIMagicSiteToSiteVPNPath string = "/proxy/network/v2/api/site/%s/magicsitetositevpn/configs"
+	// APITrafficRoutesPath returns policy-based traffic routes for a site.
+	APITrafficRoutesPath string = "/proxy/network/v2/api/site/%s/trafficroutes"
+	// APITrafficRulesPath returns traffic rules (block, allow, rate limit) for a site.
+	APITrafficRulesPath string = "/proxy/network/v2/api/site/%s/trafficrules"
+	// APIBGPConfigPath returns BGP configurations for a site.
+	APIBGPConfigPath string = "/proxy/network/v2/api/site/%s/bgp/config/all"
+	// APIOSPFRouterPath returns OSPF router configurations for a site.
+	APIOSPFRouterPath string = "/proxy/network/v2/api/site/%s/ospf/router"
+	// APINATRulesPath returns NAT rules (masquerade, source and destination NAT) for a site.
+	APINATRulesPath string = "/proxy/network/v2/api/site/%s/nat"
+	// APIVPNConnectionsPath returns live VPN connection state for a site.
+	APIVPNConnectionsPath string = "/proxy/network/v2/api/site/%s/vpn/connections"
+	// APIWireGuardUsersPath returns configured WireGuard peers for a site.
+	APIWireGuardUsersPath string = "/proxy/network/v2/api/site/%s/wireguard/users"
+	// APIQoSRulesPath returns application and port based QoS rules for a site.
+	APIQoSRulesPath string = "/proxy/network/v2/api/site/%s/qos-rules"
+	// APIContentFilteringPath returns content filtering profiles for a site.
+	APIContentFilteringPath string = "/proxy/network/v2/api/site/%s/content-filtering"
+	// APIContentFilteringCategoriesPath returns the categories available to content filtering.
+	APIContentFilteringCategoriesPath string = "/proxy/network/v2/api/site/%s/content-filtering/categories"
+	// APISSLInspectionSettingPath returns the SSL inspection setting for a site.
+	APISSLInspectionSettingPath string = "/proxy/network/v2/api/site/%s/ssl-inspection/setting"
+	// APISSLInspectionCertificatesPath returns the certificates available for SSL inspection.
+	APISSLInspectionCertificatesPath string = "/proxy/network/v2/api/site/%s/certificates"
+	// APISSLInspectionActiveCertificatePath returns the active SSL inspection certificate.
+	APISSLInspectionActiveCertificatePath string = "/proxy/network/v2/api/site/%s/certificates/active"
+	// APIStaticDNSPath returns user-defined static DNS records for a site.
+	APIStaticDNSPath string = "/proxy/network/v2/api/site/%s/static-dns"
+	// APIStaticDNSDevicesPath returns DNS records generated for clients and devices.
+	APIStaticDNSDevicesPath string = "/proxy/network/v2/api/site/%s/static-dns/devices"
+	// APIRADIUSUsersPath lists and creates RADIUS users for the built-in RADIUS server.
+	APIRADIUSUsersPath string = "/proxy/network/v2/api/site/%s/radius/users"
+	// APIRADIUSUserPath updates or deletes a single RADIUS user.
+	APIRADIUSUserPath string = "/proxy/network/v2/api/site/%s/radius/users/%s"
+	// APIFeatureExistsPath reports whether a controller feature flag is set for a site.
+	APIFeatureExistsPath string = "/proxy/network/v2/api/site/%s/features/%s/exists"
+	// APIDescribedFeaturesPath returns the feature flags known to the controller for a site.
+	APIDescribedFeaturesPath string = "/proxy/network/v2/api/site/%s/described-features"
+	// APIWLANCapabilitiesPath returns the wireless capabilities of a site, e.g. 6 GHz and WPA3 support.
+	APIWLANCapabilitiesPath string = "/proxy/network/v2/api/site/%s/wlan-capabilities"
+	// APIV2InfoPath returns Network application information.
+	APIV2InfoPath string = "/proxy/network/v2/api/info"
+	// APIFingerprintDevicesPath returns a page of the client fingerprint database (0 built-in, 1 additions).
+	APIFingerprintDevicesPath string = "/proxy/network/v2/api/fingerprint_devices/%d"
+	// APIVendorIDsPath returns the vendor names behind client dev_vendor IDs.
+	APIVendorIDsPath string = "/proxy/network/v2/api/site/%s/vendor-ids"
 	// APISysinfoPath returns controller system info and health (UniFi OS).
 	APISysinfoPath string = "/api/s/%s/stat/sysinfo"
 
@@ -201,7 +274,10 @@ const (
 	// Paths already include /proxy/network prefix and are not modified by path().
 	APIIntegrationSitesPath       string = "/proxy/network/integration/v1/sites"
 	APIIntegrationDevicesPath     string = "/proxy/network/integration/v1/sites/%s/devices"
+	APIIntegrationDevicePath      string = "/proxy/network/integration/v1/sites/%s/devices/%s"
 	APIIntegrationDeviceStatsPath string = "/proxy/network/integration/v1/sites/%s/devices/%s/statistics/latest"
+	APIIntegrationClientsPath     string = "/proxy/network/integration/v1/sites/%s/clients"
+	APIIntegrationClientPath      string = "/proxy/network/integration/v1/sites/%s/clients/%s"
 	APIWifiBroadcastsPath         string = "/proxy/network/integration/v1/sites/%s/wifi/broadcasts"
 	APIFirewallZonesPath          string = "/proxy/network/integration/v1/sites/%s/firewall/zones"
 	APIACLRulesPath               string = "/proxy/network/integration/v1/sites/%s/acl-rules"
@@ -223,10 +299,42 @@ const (
 	APICountriesPath              string = "/proxy/network/integration/v1/countries"
 
 	// Legacy gap API paths (Part A).
-	APIWANStatusPath   string = "/api/s/%s/stat/status"
-	APIUPSDevicesPath  string = "/api/s/%s/stat/ups-devices"
-	APIPortForwardPath string = "/api/s/%s/rest/portforward"
-	APISSLCertPath     string = "/api/s/%s/stat/active"
+	APIWANStatusPath           string = "/api/s/%s/stat/status"
+	APIUPSDevicesPath          string = "/api/s/%s/stat/ups-devices"
+	APIPortForwardPath         string = "/api/s/%s/rest/portforward"
+	APIPortForwardStatsPath    string = "/api/s/%s/stat/portforward"
+	APIUserGroupPath           string = "/api/s/%s/rest/usergroup"
+	APIDHCPOptionPath          string = "/api/s/%s/rest/dhcpoption"
+	APISiteHealthPath          string = "/api/s/%s/stat/health"
+	APISDNStatusPath           string = "/api/s/%s/stat/sdn"
+	APIWidgetWarningsPath      string = "/api/s/%s/stat/widget/warnings"
+	APISSLCertPath             string = "/api/s/%s/stat/active"
+	APILegacyRADIUSProfilePath string = "/api/s/%s/rest/radiusprofile"
+	APIDynamicDNSPath          string = "/api/s/%s/rest/dynamicdns"
+	APIDynamicDNSStatusPath    string = "/api/s/%s/stat/dynamicdns"
+	APIScheduleTaskPath        string = "/api/s/%s/rest/scheduletask"
+	APIHotspotPackagesPath     string = "/api/s/%s/stat/hotspotpackages"
+	APIDPIAppPath              string = "/api/s/%s/rest/dpiapp"
+	APIDPIGroupPath            string = "/api/s/%s/rest/dpigroup"
+	APICurrentChannelPath      string = "/api/s/%s/stat/current-channel"
+	APICountryCodesPath        string = "/api/s/%s/stat/ccode"
+
+	// Legacy USG firewall rule set.
+	APIFirewallGroupPath string = "/api/s/%s/rest/firewallgroup"
+	APIFirewallRulePath  string = "/api/s/%s/rest/firewallrule"
+
+	// APIStaticRoutePath returns user-defined static routes for a site.
+	APIStaticRoutePath string = "/api/s/%s/rest/routing"
+
+	// UniFi OS users API paths, shared by every application on a console.
+	// Paths start with /proxy/ and are not modified by path().
+	APIUsersOrgPath                 string = "/proxy/users/api/v2/org"
+	APIUsersControllersPath         string = "/proxy/users/api/v2/ucore/controllers"
+	APIUsersUserGroupsPath          string = "/proxy/users/api/v2/user_groups"
+	APIUsersAdminsPath              string = "/proxy/users/api/v2/users/admin/uos"
+	APIUsersSearchPath              string = "/proxy/users/api/v2/users/search"
+	APIUsersCustomRolesPath         string = "/proxy/users/api/v2/custom_roles"
+	APIUsersPermissionManifestsPath string = "/proxy/users/api/v2/permission_manifests"
 )
 
 // path returns the correct api path based on the new variable.
@@ -267,6 +375,9 @@ type Devices struct {
 	UBBs []*UBB `fakesize:"5"`
 	UCIs []*UCI `fakesize:"5"`
 	UDBs []*UDB `fakesize:"5"`
+
+	// Integration maps legacy device MACs to Integration/v1 devices; set by JoinIntegrationDevices.
+	Integration map[string]*IntegrationDevice `fake:"-" json:"-"`
 }
 
 // Config is the data passed into our library. This configures things and allows
@@ -297,6 +408,81 @@ type IntegrationInfo struct {
 	ApplicationVersion string `json:"applicationVersion"`
 }
 
+// IntegrationDevice represents an adopted device from the Integration/v1 device list.
+// Features and Interfaces name what the device supports, e.g. "switching",
+// "accessPoint" and "ports", "radios". Use GetIntegrationDevice for the details.
+type IntegrationDevice struct {
+	Features   []string `json:"features"`
+	ID         string   `json:"id"`
+	Interfaces []string `json:"interfaces"`
+	IPAddress  string   `json:"ipAddress"`
+	MacAddress string   `json:"macAddress"`
+	Model      string   `json:"model"`
+	Name       string   `json:"name"`
+	State      string   `json:"state"` // ONLINE, OFFLINE, PENDING_ADOPTION, UPDATING, GETTING_READY, ADOPTING, DELETING, CONNECTION_INTERRUPTED, ISOLATED
+
+	SiteName string `json:"-"`
+}
+
+// IntegrationDeviceDetail holds a single device from the Integration/v1 API,
+// including firmware, uplink and per-interface state.
+type IntegrationDeviceDetail struct {
+	AdoptedAt         string                      `json:"adoptedAt"`
+	ConfigurationID   string                      `json:"configurationId"`
+	Features          map[string]json.RawMessage  `json:"features"` // keyed by feature name; values are feature-specific settings
+	FirmwareUpdatable bool                        `json:"firmwareUpdatable"`
+	FirmwareVersion   string                      `json:"firmwareVersion"`
+	ID                string                      `json:"id"`
+	Interfaces        IntegrationDeviceInterfaces `json:"interfaces"`
+	IPAddress         string                      `json:"ipAddress"`
+	MacAddress        string                      `json:"macAddress"`
+	Model             string                      `json:"model"`
+	Name              string                      `json:"name"`
+	ProvisionedAt     string                      `json:"provisionedAt"`
+	State             string                      `json:"state"`
+	Supported         bool                        `json:"supported"`
+	Uplink            IntegrationDeviceUplink     `json:"uplink"`
+
+	SiteName string `json:"-"`
+}
+
+// IntegrationDeviceUplink references the device an IntegrationDeviceDetail is uplinked to.
+type IntegrationDeviceUplink struct {
+	DeviceID string `json:"deviceId"`
+}
+
+// IntegrationDeviceInterfaces holds the ports and radios of an IntegrationDeviceDetail.
+type IntegrationDeviceInterfaces struct {
+	Ports  []IntegrationDevicePort  `json:"ports"`
+	Radios []IntegrationDeviceRadio `json:"radios"`
+}
+
+// IntegrationDevicePort holds the state of a single device port.
+type IntegrationDevicePort struct {
+	Connector    string                    `json:"connector"` // RJ45, SFP, SFPPLUS, QSFP28
+	Idx          FlexInt                   `json:"idx"`
+	MaxSpeedMbps FlexInt                   `json:"maxSpeedMbps"`
+	PoE          *IntegrationDevicePortPoE `json:"poe,omitempty"`
+	SpeedMbps    FlexInt                   `json:"speedMbps"`
+	State        string                    `json:"state"` // UP, DOWN, UNKNOWN
+}
+
+// IntegrationDevicePortPoE holds PoE output settings and state of a port.
+type IntegrationDevicePortPoE struct {
+	Enabled  bool    `json:"enabled"`
+	Standard string  `json:"standard"` // 802.3af, 802.3at, 802.3bt
+	State    string  `json:"state"`    // UP, DOWN, LIMITED
+	Type     FlexInt `json:"type"`
+}
+
+// IntegrationDeviceRadio holds the settings of a single access point radio.
+type IntegrationDeviceRadio struct {
+	Channel         FlexInt `json:"channel"`
+	ChannelWidthMHz FlexInt `json:"channelWidthMHz"`
+	FrequencyGHz    FlexInt `json:"frequencyGHz"` // fractional GHz (e.g. 2.4, 5.0); use .Val, not .Int()
+	WlanStandard    string  `json:"wlanStandard"`
+}
+
 // IntegrationDeviceRadioStats holds per-radio stats within IntegrationDeviceStats.
 type IntegrationDeviceRadioStats struct {
 	FrequencyGHz FlexInt `json:"frequencyGHz"` // fractional GHz (e.g. 2.4, 5.0); use .Val, not .Int()
@@ -394,6 +580,27 @@ type IntegrationWAN struct {
 	SiteName string `json:"-"`
 }
 
+// IntegrationClientAccess describes how a client is admitted to the network.
+type IntegrationClientAccess struct {
+	Type string `json:"type"` // DEFAULT, GUEST
+}
+
+// IntegrationClient represents a connected client from the Integration/v1 API.
+// UplinkDeviceID references the ID of the access point or switch the client connects through.
+// Use ToClient to convert to the legacy Client struct.
+type IntegrationClient struct {
+	Access         IntegrationClientAccess `json:"access"`
+	ConnectedAt    string                  `json:"connectedAt"` // RFC 3339
+	ID             string                  `json:"id"`
+	IPAddress      string                  `json:"ipAddress"`
+	MacAddress     string                  `json:"macAddress"`
+	Name           string                  `json:"name"`
+	Type           string                  `json:"type"` // WIRED, WIRELESS, VPN, TELEPORT
+	UplinkDeviceID string                  `json:"uplinkDeviceId"`
+
+	SiteName string `json:"-"`
+}
+
 // VPNServerMetadata holds metadata for a VPN server.
 type VPNServerMetadata struct {
 	Origin string `json:"origin"`
@@ -407,6 +614,9 @@ type VPNServer struct {
 	Name     string            `json:"name"`
 	Type     string            `json:"type"` // L2TP, OpenVPN, WireGuard, UID
 
+	// Connections is populated by JoinVPNConnections.
+	Connections []*VPNConnection `fake:"-" json:"-"`
+
 	SiteName string `json:"-"`
 }
 
@@ -422,6 +632,9 @@ type SiteToSiteTunnel struct {
 	Name     string                   `json:"name"`
 	Type     string                   `json:"type"` // IPSec, OpenVPN, WireGuard
 
+	// Connection is populated by JoinVPNConnections.
+	Connection *VPNConnection `fake:"-" json:"-"`
+
 	SiteName string `json:"-"`
 }
 
@@ -623,12 +836,22 @@ type UnifiClient interface { //nolint: revive
 	AssociateDHCPLeases(leases []*DHCPLease, clients []*Client, devices *Devices, networks []Network) error
 	// GetWANEnrichedConfiguration returns enriched WAN configuration for all WAN interfaces.
 	GetWANEnrichedConfiguration(sites []*Site) ([]*WANEnrichedConfiguration, error)
+	// GetLANEnrichedConfiguration returns enriched LAN configuration for all LAN networks.
+	GetLANEnrichedConfiguration(sites []*Site) ([]*LANEnrichedConfiguration, error)
 	// GetWANLoadBalancingStatus returns the current load balancing status for WAN interfaces.
 	GetWANLoadBalancingStatus(sites []*Site) (*WANLoadBalancingStatus, error)
 	// GetWANISPStatus returns the ISP status for WAN interfaces.
 	GetWANISPStatus(sites []*Site, wanNetworkgroup string) (*WANISPStatusDetailed, error)
 	// GetWANSLAs returns WAN SLA monitoring data.
 	GetWANSLAs(sites []*Site) ([]*WANSLA, error)
+	// GetWANNetworkGroups returns per-WAN port information for a site.
+	GetWANNetworkGroups(site *Site) ([]*WANNetworkGroup, error)
+	// GetWANMagicConfiguration returns WAN data usage cap settings for a site.
+	GetWANMagicConfiguration(site *Site) ([]*WANMagicConfiguration, error)
+	// GetWANMagicSubscription returns metered WAN subscription usage for a site.
+	GetWANMagicSubscription(site *Site) ([]*WANMagicSubscription, error)
+	// GetWANOverview merges all WAN data sources into one record per WAN for a site.
+	GetWANOverview(site *Site) ([]*WANOverview, error)
 	// GetSites returns a list of configured sites on the UniFi controller.
 	GetSites() ([]*Site, error)
 	// GetSiteDPI garners dpi data for sites.
@@ -655,6 +878,9 @@ type UnifiClient interface { //nolint: revive
 	GetClientTrafficByMac(site *Site, epochMillisTimePeriod *EpochMillisTimePeriod, includeUnidentified bool, macs ...string) ([]*ClientUsageByApp, error)
 	// GetCountryTraffic returns a response full of clients' traffic data from the UniFi Controller for the provided time period.'
 	GetCountryTraffic(sites []*Site, epochMillisTimePeriod *EpochMillisTimePeriod) ([]*UsageByCountry, error)
+	// GetAppTrafficRate returns per-application throughput time series for the provided time period,
+	// optionally limited to the given application IDs and client mac addresses.
+	GetAppTrafficRate(site *Site, epochMillisTimePeriod *EpochMillisTimePeriod, apps []int, macs []string) ([]*AppTrafficRate, error)
 	// GetProtectLogs returns Protect system log events.
 	GetProtectLogs(req *ProtectLogRequest) ([]*ProtectLogEntry, error)
 	// GetSysinfo returns controller system info and health (UniFi OS).
@@ -669,6 +895,10 @@ type UnifiClient interface { //nolint: revive
 	GetMagicSiteToSiteVPN(sites []*Site) ([]*MagicSiteToSiteVPN, error)
 	// GetMagicSiteToSiteVPNSite returns Site Magic site-to-site VPN mesh configurations for a single Site.
 	GetMagicSiteToSiteVPNSite(site *Site) ([]*MagicSiteToSiteVPN, error)
+	// GetVPNConnections returns live VPN connection state for a site.
+	GetVPNConnections(site *Site) ([]*VPNConnection, error)
+	// GetWireGuardUsers returns configured WireGuard peers for a site.
+	GetWireGuardUsers(site *Site) ([]*WireGuardUser, error)
 	// GetIntegrationSites returns all sites from the Integration/v1 API.
 	// Requires Config.APIKey; join on InternalReference == Site.Name to get UUID.
 	GetIntegrationSites() ([]*IntegrationSite, error)
@@ -678,6 +908,10 @@ type UnifiClient interface { //nolint: revive
 	GetIntegrationDeviceStats(site *IntegrationSite, deviceID string) (*IntegrationDeviceStats, error)
 	// GetAllIntegrationDeviceStats returns statistics for all devices in a site.
 	GetAllIntegrationDeviceStats(site *IntegrationSite) ([]*IntegrationDeviceStats, error)
+	// GetIntegrationDevices returns adopted devices for a site from the Integration/v1 API.
+	GetIntegrationDevices(site *IntegrationSite) ([]*IntegrationDevice, error)
+	// GetIntegrationDevice returns model, firmware, uplink and interface details for a single device.
+	GetIntegrationDevice(site *IntegrationSite, deviceID string) (*IntegrationDeviceDetail, error)
 	// GetWifiBroadcasts returns WiFi broadcast (SSID) configurations for a site.
 	GetWifiBroadcasts(site *IntegrationSite) ([]*WifiBroadcast, error)
 	// GetFirewallZones returns firewall zones for a site. Zone IDs appear in firewall policies.
@@ -688,6 +922,10 @@ type UnifiClient interface { //nolint: revive
 	GetIntegrationNetworks(site *IntegrationSite) ([]*IntegrationNetwork, error)
 	// GetIntegrationWANs returns WAN interface identifiers for a site.
 	GetIntegrationWANs(site *IntegrationSite) ([]*IntegrationWAN, error)
+	// GetIntegrationClients returns connected clients for a site from the Integration/v1 API.
+	GetIntegrationClients(site *IntegrationSite) ([]*IntegrationClient, error)
+	// GetIntegrationClient returns a single connected client from the Integration/v1 API.
+	GetIntegrationClient(site *IntegrationSite, clientID string) (*IntegrationClient, error)
 	// GetVPNServers returns VPN server configurations for a site.
 	GetVPNServers(site *IntegrationSite) ([]*VPNServer, error)
 	// GetSiteToSiteTunnels returns site-to-site VPN tunnel configurations for a site.
@@ -720,8 +958,114 @@ type UnifiClient interface { //nolint: revive
 	GetUPSDeviceList(site *Site) ([]*UPSDeviceSelector, error)
 	// GetPortForwards returns port forwarding rules for a site.
 	GetPortForwards(site *Site) ([]*PortForward, error)
+	// GetPortForwardStats returns port forward hit counters for a site.
+	GetPortForwardStats(site *Site) ([]*PortForwardStats, error)
 	// GetSSLCertificate returns SSL certificate information for a site.
 	GetSSLCertificate(site *Site) (*SSLCertificate, error)
+	// GetFirewallGroups returns legacy firewall address and port groups for a site.
+	GetFirewallGroups(site *Site) ([]*FirewallGroup, error)
+	// GetFirewallRules returns legacy (USG-style) firewall rules for a site.
+	GetFirewallRules(site *Site) ([]*FirewallRule, error)
+	// GetStaticRoutes returns user-defined static routes for a site.
+	GetStaticRoutes(site *Site) ([]*StaticRoute, error)
+	// GetTrafficRoutes returns policy-based traffic routes for a site.
+	GetTrafficRoutes(site *Site) ([]*TrafficRoute, error)
+	// GetTrafficRules returns traffic rules for a site.
+	GetTrafficRules(site *Site) ([]*TrafficRule, error)
+	// GetBGPConfig returns BGP configurations for a site.
+	GetBGPConfig(site *Site) ([]*BGPConfig, error)
+	// GetOSPFRouters returns OSPF router configurations for a site.
+	GetOSPFRouters(site *Site) ([]*OSPFRouter, error)
+	// GetNATRules returns NAT rules for a site.
+	GetNATRules(site *Site) ([]*NATRule, error)
+	// GetUserGroups returns client bandwidth profiles (user groups) for a site.
+	GetUserGroups(site *Site) ([]*UserGroup, error)
+	// GetQoSRules returns QoS rules for a site.
+	GetQoSRules(site *Site) ([]*QoSRule, error)
+	// GetContentFilteringProfiles returns content filtering profiles for a site.
+	GetContentFilteringProfiles(site *Site) ([]*ContentFilteringProfile, error)
+	// GetContentFilteringCategories returns the categories available to content filtering.
+	GetContentFilteringCategories(site *Site) ([]*ContentFilteringCategory, error)
+	// GetSSLInspectionSetting returns the SSL inspection setting for a site.
+	GetSSLInspectionSetting(site *Site) (*SSLInspectionSetting, error)
+	// GetSSLInspectionCertificates returns the certificates available for SSL inspection.
+	GetSSLInspectionCertificates(site *Site) ([]*SSLCertificate, error)
+	// GetActiveSSLInspectionCertificate returns the active SSL inspection certificate.
+	GetActiveSSLInspectionCertificate(site *Site) (*SSLCertificate, error)
+	// GetStaticDNSRecords returns user-defined static DNS records for a site.
+	GetStaticDNSRecords(site *Site) ([]*StaticDNSRecord, error)
+	// GetStaticDNSDeviceRecords returns DNS records generated for clients and devices on a site.
+	GetStaticDNSDeviceRecords(site *Site) ([]*StaticDNSRecord, error)
+	// GetDHCPOptions returns custom DHCP option definitions for a site.
+	GetDHCPOptions(site *Site) ([]*DHCPOption, error)
+	// GetMDNSConfiguration returns the mDNS reflector settings for a site.
+	GetMDNSConfiguration(site *Site) (*MDNSConfiguration, error)
+	// GetGlobalNetworkConfig returns site-wide network settings for a site.
+	GetGlobalNetworkConfig(site *Site) (*GlobalNetworkConfig, error)
+	// GetExcludedIPs returns addresses and ranges excluded from DHCP pools on a site.
+	GetExcludedIPs(site *Site) ([]*ExcludedIPRange, error)
+	// GetSiteHealth returns the subsystem health summary for a site.
+	GetSiteHealth(site *Site) ([]*SubsystemHealth, error)
+	// GetSDNStatus returns the controller's cloud connection state for a site.
+	GetSDNStatus(site *Site) (*SDNStatus, error)
+	// GetWidgetWarnings returns the dashboard warnings widget for a site.
+	GetWidgetWarnings(site *Site) (*WidgetWarnings, error)
+	// FirmwareReport combines device firmware state with the warnings widget across sites.
+	FirmwareReport(sites []*Site) (*FirmwareReport, error)
+	// GetAPGroups returns access point groups for a site.
+	GetAPGroups(site *Site) ([]*APGroup, error)
+	// GetWirelessLinks returns mesh and point-to-point wireless uplinks for a site.
+	GetWirelessLinks(site *Site) ([]*WirelessLink, error)
+	// GetPortMACTables returns learned MAC addresses per switch port, optionally limited to deviceMACs.
+	GetPortMACTables(site *Site, deviceMACs ...string) ([]*PortMACTable, error)
+	// LocateMAC returns the switch port where mac was learned, preferring edge ports over uplinks.
+	LocateMAC(site *Site, mac string) (*MACLocation, error)
+	// GetLegacyRADIUSProfiles returns RADIUS profiles from the legacy REST API for a site.
+	GetLegacyRADIUSProfiles(site *Site) ([]*LegacyRADIUSProfile, error)
+	// GetRADIUSUsers returns users of the built-in RADIUS server for a site.
+	GetRADIUSUsers(site *Site) ([]*RADIUSUser, error)
+	// CreateRADIUSUser adds a user to the built-in RADIUS server.
+	CreateRADIUSUser(site *Site, user *RADIUSUser) (*RADIUSUser, error)
+	// UpdateRADIUSUser replaces an existing RADIUS user identified by user.ID.
+	UpdateRADIUSUser(site *Site, user *RADIUSUser) (*RADIUSUser, error)
+	// DeleteRADIUSUser removes a user from the built-in RADIUS server.
+	DeleteRADIUSUser(site *Site, id string) error
+	// GetDynamicDNS returns dynamic DNS configurations for a site.
+	GetDynamicDNS(site *Site) ([]*DynamicDNS, error)
+	// GetDynamicDNSStatus returns the last update pushed to each dynamic DNS provider for a site.
+	GetDynamicDNSStatus(site *Site) ([]*DynamicDNSStatus, error)
+	// GetDynamicDNSHealth reports whether each dynamic DNS hostname points at the current WAN IP.
+	GetDynamicDNSHealth(site *Site) ([]*DynamicDNSCheck, error)
+	// GetScheduleTasks returns scheduled tasks (upgrades, speed tests) for a site.
+	GetScheduleTasks(site *Site) ([]*ScheduleTask, error)
+	// GetHotspotPackages returns hotspot payment packages for a site.
+	GetHotspotPackages(site *Site) ([]*HotspotPackage, error)
+	// Capabilities returns the probed feature flags and wireless capabilities of a site. Results are cached.
+	Capabilities(site *Site) (*Capabilities, error)
+	// FeatureExists reports whether a controller feature flag is set for a site. Results are cached.
+	FeatureExists(site *Site, feature string) (bool, error)
+	// GetDPIApps returns user-defined DPI restrictions (blocked or rate limited apps) for a site.
+	GetDPIApps(site *Site) ([]*DPIApp, error)
+	// GetDPIGroups returns user-defined DPI groups for a site.
+	GetDPIGroups(site *Site) ([]*DPIGroup, error)
+	// RefreshDPIMaps adds the controller's DPI catalogue names to DPIApps and DPICats.
+	RefreshDPIMaps() (int, error)
+	// GetCountryCodes returns the regulatory domains the controller knows about.
+	GetCountryCodes(site *Site) ([]*CountryCode, error)
+	// GetChannelPlan returns the channels allowed for the site's country code.
+	GetChannelPlan(site *Site) (*ChannelPlan, error)
+	// CheckUAPRadios validates each access point radio's channel, width and tx power against the site's channel plan.
+	CheckUAPRadios(site *Site) ([]*RadioIssue, error)
+	// GetFingerprintDatabase returns one page of the client fingerprint database.
+	GetFingerprintDatabase(page int) (*FingerprintDatabase, error)
+	// GetVendorIDs returns the vendor names behind client dev_vendor IDs for a site.
+	GetVendorIDs(site *Site) ([]*VendorID, error)
+	// Fingerprints returns the cached fingerprint tables used to name client vendors, families and OSes.
+	Fingerprints(site *Site) (*Fingerprints, error)
+	// SetFingerprints replaces the cached fingerprint tables, e.g. with an offline snapshot.
+	SetFingerprints(f *Fingerprints)
+	// UsersAPI returns a client for the UniFi OS users and organization API of the console.
+	UsersAPI() *UsersAPI
 	// AuthorizeGuest authorizes a guest client by MAC on a site for the given
 	// number of minutes. Pass minutes=0 to use the controller default.
 	AuthorizeGuest(site *Site, mac string, minutes int) error
@@ -739,6 +1083,13 @@ type Unifi struct {
 	fingerprints              fingerprints
 	new                       bool
 	deviceTagsUnavailableOnce sync.Once
+	systemLogFilters          map[string]*systemLogFilterEntry
+	systemLogFiltersMu        sync.Mutex
+	capabilities              map[string]*capabilitiesEntry
+	featureFlags              map[string]map[string]bool
+	capabilitiesMu            sync.Mutex
+	fingerprintDB             *fingerprintEntry
+	fingerprintDBMu           sync.Mutex
 }
 
 // ensure Unifi implements UnifiClient fully, will fail to compile otherwise
@@ -773,11 +1124,7 @@ func (s *ServerStatus) MajorVersion() int {
 		return 0
 	}
 
-	v := strings.TrimLeft(s.ServerVersion, "vV")
-	major, _, _ := strings.Cut(v, ".")
-	n, _ := strconv.Atoi(major)
-
-	return n
+	return parseMajorVersion(s.ServerVersion)
 }
 
 type FlexString struct {
@@ -150,6 +150,7 @@ type UAP struct {
 	Unsupported                   FlexBool        `json:"unsupported"`
 	UnsupportedReason             FlexInt         `json:"unsupported_reason"`
 	Upgradable                    FlexBool        `json:"upgradable"`
+	UpgradeToFirmware             string          `json:"upgrade_to_firmware"`
 	UpgradeState                  FlexInt         `json:"upgrade_state"`
 	Uplink                        struct {
 		FullDuplex       FlexBool `json:"full_duplex"`
@@ -110,6 +110,7 @@ type UCI struct {
 	Unsupported              FlexBool        `json:"unsupported"`
 	UnsupportedReason        FlexInt         `json:"unsupported_reason"`
 	Upgradable               FlexBool        `json:"upgradable"`
+	UpgradeToFirmware        string          `json:"upgrade_to_firmware"`
 	UpgradeDuration          FlexInt         `json:"upgrade_duration"`
 	Uptime                   FlexInt         `json:"uptime"`
 	Uptime0                  FlexInt         `json:"_uptime"`
@@ -118,6 +118,7 @@ type UDB struct {
 	Unsupported             FlexBool        `json:"unsupported"`
 	UnsupportedReason       FlexInt         `json:"unsupported_reason"`
 	Upgradable              FlexBool        `json:"upgradable,omitempty"`
+	UpgradeToFirmware       string          `json:"upgrade_to_firmware"`
 	Upgradeable             FlexBool        `json:"upgradeable"`
 	Uplink                  Uplink          `json:"uplink"`
 	UplinkDepth             FlexInt         `json:"uplink_depth"`
@@ -156,6 +156,7 @@ type UDM struct {
 	Unsupported       FlexBool      `json:"unsupported"`
 	UnsupportedReason FlexInt       `json:"unsupported_reason"`
 	UpgradeState      FlexInt       `json:"upgrade_state"`
+	UpgradeToFirmware string        `json:"upgrade_to_firmware"`
 	Upgradeable       FlexBool      `json:"upgradable"`
 	Uplink            Uplink        `json:"uplink"`
 	Uptime            FlexInt       `json:"uptime"`
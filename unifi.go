@@ -472,6 +472,20 @@ func (u *Unifi) UniReqPost(apiPath string, params string) (*http.Request, error)
 	return req, nil
 }
 
+// UniReqDelete is the Delete call equivalent to UniReq.
+func (u *Unifi) UniReqDelete(apiPath string, params string) (*http.Request, error) {
+	apiPath = u.path(apiPath)
+
+	req, err := http.NewRequest(http.MethodDelete, u.URL+apiPath, bytes.NewBufferString(params)) //nolint:noctx
+	if err != nil {
+		return nil, fmt.Errorf("creating request: %w", err)
+	}
+
+	u.setHeaders(req, params)
+
+	return req, nil
+}
+
 // GetJSON returns the raw JSON from a path. This is useful for debugging.
 func (u *Unifi) GetJSON(apiPath string, params ...string) ([]byte, error) {
 	if u == nil {
@@ -494,7 +508,7 @@ func (u *Unifi) PutJSON(apiPath string, params ...string) ([]byte, error) {
 		return []byte{}, err
 	}
 
-	return u.do(req)
+	return u.doWrite(req)
 }
 
 // PostJSON uses a POST call and returns the raw JSON in the same way as GetData
@@ -505,7 +519,18 @@ func (u *Unifi) PostJSON(apiPath string, params ...string) ([]byte, error) {
 		return []byte{}, err
 	}
 
-	return u.do(req)
+	return u.doWrite(req)
+}
+
+// DeleteJSON uses a DELETE call and returns the raw JSON in the same way as GetData
+// Use this if you want to remove data via the REST API.
+func (u *Unifi) DeleteJSON(apiPath string, params ...string) ([]byte, error) {
+	req, err := u.UniReqDelete(apiPath, strings.Join(params, " "))
+	if err != nil {
+		return []byte{}, err
+	}
+
+	return u.doWrite(req)
 }
 
 // Probe performs a GET request to the given API path and returns the HTTP status code.
@@ -539,6 +564,16 @@ func (u *Unifi) Probe(apiPath string, params ...string) (int, error) {
 }
 
 func (u *Unifi) do(req *http.Request) ([]byte, error) {
+	return u.doRequest(req, false)
+}
+
+// doWrite is do for PutJSON, PostJSON and DeleteJSON, which also accept
+// 201 Created and 204 No Content.
+func (u *Unifi) doWrite(req *http.Request) ([]byte, error) {
+	return u.doRequest(req, true)
+}
+
+func (u *Unifi) doRequest(req *http.Request, write bool) ([]byte, error) {
 	var (
 		cancel func()
 		ctx    = context.Background()
@@ -597,7 +632,9 @@ func (u *Unifi) do(req *http.Request) ([]byte, error) {
 
 	if resp.StatusCode == http.StatusNotFound {
 		err = fmt.Errorf("%s: %w", req.URL, ErrEndpointNotFound)
-	} else if resp.StatusCode != http.StatusOK {
+	} else if resp.StatusCode != http.StatusOK && (!write ||
+		resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices) {
+		// Writes may answer 201 Created or 204 No Content; reads must return 200.
 		err = fmt.Errorf("%s: %s: %w", req.URL, resp.Status, ErrInvalidStatusCode)
 	}
 
@@ -366,3 +366,27 @@ func TestDo_PlainJSONBody(t *testing.T) {
 	require.NoError(t, err)
 	assert.Equal(t, payload, got)
 }
+
+// TestDo_NoContent covers the 2xx relaxation for the write helpers: a DELETE may
+// answer 204 No Content, but reads, including POST-based ones, must still return 200.
+func TestDo_NoContent(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer srv.Close()
+
+	u := &Unifi{Client: srv.Client(), Config: &Config{URL: srv.URL, DebugLog: discardLogs}}
+
+	_, err := u.DeleteJSON("/proxy/network/v2/api/site/default/radius/users/1")
+	require.NoError(t, err)
+
+	_, err = u.GetJSON("/api/s/default/self/sites")
+	require.Error(t, err)
+	assert.ErrorIs(t, err, ErrInvalidStatusCode, "a GET answering 204 must not look like success")
+
+	_, err = u.GetJSON("/v2/api/site/default/system-log/count", "{}")
+	require.Error(t, err)
+	assert.ErrorIs(t, err, ErrInvalidStatusCode, "a POST-based read answering 204 must not look like success")
+}
@@ -0,0 +1,377 @@
+package unifi
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+)
+
+// UniFi OS application install states reported in UniFiOSController.InstallState.
+const (
+	UniFiOSAppInstalled    = "installed"
+	UniFiOSAppNotInstalled = "notInstalled"
+)
+
+// UsersAPI reads the UniFi OS users and organization API under /proxy/users/api/v2.
+// It is shared by every application on a console, so it describes who can log in
+// to the console rather than clients of the Network application. Get one from
+// Unifi.UsersAPI. Only UniFi OS consoles serve these endpoints.
+type UsersAPI struct {
+	u *Unifi
+}
+
+// UsersOrg is the organization a UniFi OS console belongs to.
+type UsersOrg struct {
+	OrgID     string `json:"org_id"`
+	Name      string `json:"name"`
+	Domain    string `json:"domain"`
+	Subdomain string `json:"subdomain"`
+
+	SourceName string `json:"-"`
+}
+
+// UsersAPIGroup is a UniFi OS user group; UserGroup is the Network bandwidth group.
+// UpIDs are the IDs of the users in the group.
+type UsersAPIGroup struct {
+	ID       string   `json:"unique_id"`
+	Name     string   `json:"name"`
+	UpIDs    []string `json:"up_ids"`
+	SystemID string   `json:"system_id,omitempty"`
+
+	SourceName string `json:"-"`
+}
+
+// UsersAPIRole is a role assigned to a UniFi OS user, e.g. "Super Admin".
+type UsersAPIRole struct {
+	ID         string   `json:"unique_id"`
+	Name       string   `json:"name"`
+	SystemKey  string   `json:"system_key,omitempty"` // e.g. super_administrator
+	SystemRole FlexBool `json:"system_role"`
+	Level      FlexInt  `json:"level"`
+}
+
+// UsersAPIUser is a UniFi OS user from users/admin/uos or users/search.
+// Permissions maps an application scope, e.g. "network.management", to its grants.
+// CreateTime and LoginTime are Unix timestamps; LoginTime is 0 when the user never logged in.
+type UsersAPIUser struct {
+	ID               string              `json:"unique_id"`
+	Alias            string              `json:"alias,omitempty"`
+	Email            string              `json:"email"`
+	FirstName        string              `json:"first_name"`
+	FullName         string              `json:"full_name"`
+	LastName         string              `json:"last_name"`
+	Username         string              `json:"username"`
+	Status           string              `json:"status"` // e.g. ACTIVE or PENDING
+	IsOwner          FlexBool            `json:"isOwner"`
+	OnlyLocalAccount FlexBool            `json:"only_local_account"`
+	Roles            []UsersAPIRole      `json:"roles"`
+	Groups           []UsersAPIGroup     `json:"groups"`
+	Permissions      map[string][]string `json:"permissions"`
+	CreateTime       FlexInt             `json:"create_time"`
+	LoginTime        FlexInt             `json:"login_time"`
+
+	SourceName string `json:"-"`
+}
+
+// DisplayName returns the best available name for the user.
+func (a *UsersAPIUser) DisplayName() string {
+	return pick(a.FullName, a.Alias, a.Username, a.Email, a.ID)
+}
+
+// LastLogin returns the time of the user's last login, or the zero time if they never logged in.
+func (a *UsersAPIUser) LastLogin() time.Time {
+	if a.LoginTime.Val <= 0 {
+		return time.Time{}
+	}
+
+	return time.Unix(int64(a.LoginTime.Val), 0)
+}
+
+// CustomRole is a user-defined UniFi OS role. Permissions maps an application
+// scope to its grants, as in UsersAPIUser.
+type CustomRole struct {
+	ID          string              `json:"unique_id"`
+	Name        string              `json:"name"`
+	Description string              `json:"description,omitempty"`
+	Permissions map[string][]string `json:"permissions"`
+
+	SourceName string `json:"-"`
+}
+
+// PermissionManifest lists the permissions a UniFi OS application, e.g. "network",
+// offers for roles.
+type PermissionManifest struct {
+	Name        string                   `json:"name"`
+	DisplayName string                   `json:"display_name,omitempty"`
+	Permissions []PermissionManifestItem `json:"permissions"`
+
+	SourceName string `json:"-"`
+}
+
+// PermissionManifestItem is a single grant in a PermissionManifest.
+type PermissionManifestItem struct {
+	Key         string `json:"key"`
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+}
+
+// UniFiOSController is a UniFi OS application, e.g. network or protect, from
+// ucore/controllers. InstallState is UniFiOSAppInstalled once it is installed.
+type UniFiOSController struct {
+	Name            string   `json:"name"`
+	Type            string   `json:"type"`
+	Version         string   `json:"version"`
+	Port            FlexInt  `json:"port"`
+	InstallState    string   `json:"installState"`
+	IsConfigured    FlexBool `json:"isConfigured"`
+	IsRunning       FlexBool `json:"isRunning"`
+	UpdateAvailable string   `json:"updateAvailable,omitempty"` // newer version, if any
+
+	SourceName string `json:"-"`
+}
+
+// Installed reports whether the application is installed on the console.
+func (c *UniFiOSController) Installed() bool {
+	return c.InstallState == UniFiOSAppInstalled
+}
+
+// AdminAccess is one row of an access review: an admin of a console with their
+// roles and last login. Console is the URL of the console the admin was read from.
+type AdminAccess struct {
+	Console   string
+	Org       string
+	ID        string
+	Name      string
+	Email     string
+	Username  string
+	Status    string
+	Owner     bool
+	Roles     []string
+	LastLogin time.Time // zero when the admin never logged in
+}
+
+// UsersAPI returns a client for the UniFi OS users and organization API of this console.
+func (u *Unifi) UsersAPI() *UsersAPI {
+	return &UsersAPI{u: u}
+}
+
+// Org returns the organization the console belongs to.
+// Uses the UniFi OS endpoint: GET /proxy/users/api/v2/org
+func (a *UsersAPI) Org() (*UsersOrg, error) {
+	var org UsersOrg
+	if err := a.get(APIUsersOrgPath, "organization", &org); err != nil {
+		return nil, err
+	}
+
+	org.SourceName = a.u.URL
+
+	return &org, nil
+}
+
+// UserGroups returns the console's user groups.
+// Uses the UniFi OS endpoint: GET /proxy/users/api/v2/user_groups
+func (a *UsersAPI) UserGroups() ([]*UsersAPIGroup, error) {
+	var groups []*UsersAPIGroup
+	if err := a.get(APIUsersUserGroupsPath, "user groups", &groups); err != nil {
+		return nil, err
+	}
+
+	return withUsersSource(groups, a.u.URL, func(g *UsersAPIGroup, s string) { g.SourceName = s }), nil
+}
+
+// Admins returns the console's administrators with their roles and permissions.
+// Uses the UniFi OS endpoint: GET /proxy/users/api/v2/users/admin/uos
+func (a *UsersAPI) Admins() ([]*UsersAPIUser, error) {
+	return a.users(APIUsersAdminsPath, "admins")
+}
+
+// Users returns every user known to the console, including those without admin roles.
+// Uses the UniFi OS endpoint: GET /proxy/users/api/v2/users/search
+func (a *UsersAPI) Users() ([]*UsersAPIUser, error) {
+	return a.users(APIUsersSearchPath, "users")
+}
+
+// CustomRoles returns the user-defined roles of the console.
+// Uses the UniFi OS endpoint: GET /proxy/users/api/v2/custom_roles
+func (a *UsersAPI) CustomRoles() ([]*CustomRole, error) {
+	var roles []*CustomRole
+	if err := a.get(APIUsersCustomRolesPath, "custom roles", &roles); err != nil {
+		return nil, err
+	}
+
+	return withUsersSource(roles, a.u.URL, func(r *CustomRole, s string) { r.SourceName = s }), nil
+}
+
+// PermissionManifests returns the permissions each installed application offers for roles.
+// Uses the UniFi OS endpoint: GET /proxy/users/api/v2/permission_manifests
+func (a *UsersAPI) PermissionManifests() ([]*PermissionManifest, error) {
+	var manifests []*PermissionManifest
+	if err := a.get(APIUsersPermissionManifestsPath, "permission manifests", &manifests); err != nil {
+		return nil, err
+	}
+
+	return withUsersSource(manifests, a.u.URL, func(m *PermissionManifest, s string) { m.SourceName = s }), nil
+}
+
+// Controllers returns every UniFi OS application the console knows about,
+// installed or not. See InstalledApps.
+// Uses the UniFi OS endpoint: GET /proxy/users/api/v2/ucore/controllers
+func (a *UsersAPI) Controllers() ([]*UniFiOSController, error) {
+	var controllers []*UniFiOSController
+	if err := a.get(APIUsersControllersPath, "controllers", &controllers); err != nil {
+		return nil, err
+	}
+
+	return withUsersSource(controllers, a.u.URL, func(c *UniFiOSController, s string) { c.SourceName = s }), nil
+}
+
+// InstalledApps returns the UniFi OS applications installed on the console.
+func (a *UsersAPI) InstalledApps() ([]*UniFiOSController, error) {
+	controllers, err := a.Controllers()
+	if err != nil {
+		return nil, err
+	}
+
+	installed := make([]*UniFiOSController, 0, len(controllers))
+
+	for _, c := range controllers {
+		if c.Installed() {
+			installed = append(installed, c)
+		}
+	}
+
+	return installed, nil
+}
+
+// AccessReview lists every admin of the console with their role names and last
+// login, sorted by name. The organization name is included when available.
+func (a *UsersAPI) AccessReview() ([]*AdminAccess, error) {
+	admins, err := a.Admins()
+	if err != nil {
+		return nil, err
+	}
+
+	orgName := ""
+
+	if org, err := a.Org(); err != nil {
+		a.u.DebugLog("UniFi OS organization unavailable for access review: %v", err)
+	} else {
+		orgName = org.Name
+	}
+
+	review := make([]*AdminAccess, 0, len(admins))
+
+	for _, admin := range admins {
+		access := &AdminAccess{
+			Console:   a.u.URL,
+			Org:       orgName,
+			ID:        admin.ID,
+			Name:      admin.DisplayName(),
+			Email:     admin.Email,
+			Username:  admin.Username,
+			Status:    admin.Status,
+			Owner:     admin.IsOwner.Val,
+			Roles:     make([]string, 0, len(admin.Roles)),
+			LastLogin: admin.LastLogin(),
+		}
+
+		for _, role := range admin.Roles {
+			access.Roles = append(access.Roles, role.Name)
+		}
+
+		review = append(review, access)
+	}
+
+	sort.SliceStable(review, func(i, j int) bool { return review[i].Name < review[j].Name })
+
+	return review, nil
+}
+
+// ReviewAdminAccess runs AccessReview on each console and combines the results,
+// ordered by console and then name. Consoles that fail are skipped; their errors
+// are joined and returned with the rows that were collected.
+func ReviewAdminAccess(consoles ...*Unifi) ([]*AdminAccess, error) {
+	var (
+		review []*AdminAccess
+		errs   []error
+	)
+
+	for _, u := range consoles {
+		if u == nil || u.Config == nil {
+			errs = append(errs, ErrNilUnifi)
+
+			continue
+		}
+
+		rows, err := u.UsersAPI().AccessReview()
+		if err != nil {
+			errs = append(errs, fmt.Errorf("reviewing admin access on %s: %w", u.URL, err))
+
+			continue
+		}
+
+		review = append(review, rows...)
+	}
+
+	return review, errors.Join(errs...)
+}
+
+// users fetches a list of UniFi OS users from apiPath.
+func (a *UsersAPI) users(apiPath, name string) ([]*UsersAPIUser, error) {
+	var users []*UsersAPIUser
+	if err := a.get(apiPath, name, &users); err != nil {
+		return nil, err
+	}
+
+	return withUsersSource(users, a.u.URL, func(u *UsersAPIUser, s string) { u.SourceName = s }), nil
+}
+
+// get fetches apiPath and decodes it into v. The users API wraps most responses
+// in {"code":..., "data":...}; bare objects and arrays are accepted too.
+func (a *UsersAPI) get(apiPath, name string, v any) error {
+	if a == nil || a.u == nil || a.u.Config == nil {
+		return ErrNilUnifi
+	}
+
+	a.u.DebugLog("Polling UniFi OS for %s", name)
+
+	body, err := a.u.GetJSON(apiPath)
+	if err != nil {
+		return fmt.Errorf("failed to fetch UniFi OS %s from %s: %w", name, a.u.URL, err)
+	}
+
+	if trimmed := bytes.TrimSpace(body); len(trimmed) > 0 && trimmed[0] == '{' {
+		var envelope struct {
+			Data json.RawMessage `json:"data"`
+		}
+
+		if err := json.Unmarshal(trimmed, &envelope); err == nil && len(envelope.Data) > 0 && string(envelope.Data) != "null" {
+			body = envelope.Data
+		}
+	}
+
+	if err := json.Unmarshal(body, v); err != nil {
+		return fmt.Errorf("failed to parse UniFi OS %s from %s: %w", name, a.u.URL, err)
+	}
+
+	return nil
+}
+
+// withUsersSource drops nil entries from items and sets their source name.
+func withUsersSource[T any](items []*T, source string, set func(*T, string)) []*T {
+	result := make([]*T, 0, len(items))
+
+	for _, item := range items {
+		if item == nil {
+			continue
+		}
+
+		set(item, source)
+		result = append(result, item)
+	}
+
+	return result
+}
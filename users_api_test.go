@@ -0,0 +1,65 @@
+package unifi // nolint: testpackage
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUsersAPIAccessReview(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/proxy/users/api/v2/org":
+			_, _ = w.Write([]byte(`{"org_id":"o1","name":"Example Corp","domain":"example.com"}`))
+		case "/proxy/users/api/v2/users/admin/uos":
+			_, _ = w.Write([]byte(`{"code":0,"codeS":"SUCCESS","data":[
+				{"unique_id":"a2","username":"zoe","full_name":"Zoe Admin","status":"ACTIVE",
+				 "roles":[{"unique_id":"r2","name":"Network Viewer"}],"login_time":0},
+				null,
+				{"unique_id":"a1","username":"amy","full_name":"Amy Owner","status":"ACTIVE","isOwner":true,
+				 "roles":[{"unique_id":"r1","name":"Super Admin","system_key":"super_administrator","system_role":true}],
+				 "login_time":1700000000}
+			]}`))
+		case "/proxy/users/api/v2/ucore/controllers":
+			_, _ = w.Write([]byte(`[{"name":"network","type":"network","version":"10.0.140","port":8081,"installState":"installed","isRunning":true},
+				{"name":"protect","type":"protect","installState":"notInstalled"}]`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer srv.Close()
+
+	u := &Unifi{
+		Client: &http.Client{},
+		Config: &Config{URL: srv.URL, DebugLog: discardLogs, ErrorLog: discardLogs},
+	}
+
+	review, err := ReviewAdminAccess(u)
+	require.NoError(t, err)
+	require.Len(t, review, 2)
+	assert.Equal(t, "Amy Owner", review[0].Name)
+	assert.Equal(t, []string{"Super Admin"}, review[0].Roles)
+	assert.True(t, review[0].Owner)
+	assert.Equal(t, int64(1700000000), review[0].LastLogin.Unix())
+	assert.Equal(t, "Example Corp", review[0].Org)
+	assert.Equal(t, srv.URL, review[0].Console)
+	assert.True(t, review[1].LastLogin.IsZero())
+
+	apps, err := u.UsersAPI().InstalledApps()
+	require.NoError(t, err)
+	require.Len(t, apps, 1)
+	assert.Equal(t, "network", apps[0].Name)
+	assert.Equal(t, 8081, apps[0].Port.Int())
+
+	_, err = u.UsersAPI().CustomRoles()
+	require.Error(t, err)
+
+	_, err = ReviewAdminAccess(u, &Unifi{Client: &http.Client{}, Config: &Config{URL: srv.URL + "/missing", DebugLog: discardLogs, ErrorLog: discardLogs}})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "reviewing admin access on "+srv.URL+"/missing:")
+}
@@ -62,6 +62,7 @@ type USG struct {
 	Unsupported           FlexBool        `json:"unsupported"`
 	UnsupportedReason     FlexInt         `json:"unsupported_reason"`
 	Upgradable            FlexBool        `json:"upgradable"`
+	UpgradeToFirmware     string          `json:"upgrade_to_firmware"`
 	Uplink                Uplink          `json:"uplink"`
 	Uptime                FlexInt         `json:"uptime"`
 	UserNumSta            FlexInt         `json:"user-num_sta"`
@@ -107,6 +108,8 @@ type Uplink struct {
 	TxRate           FlexInt  `json:"tx_rate"`
 	Type             string   `json:"type"`
 	Up               FlexBool `json:"up"`
+	UplinkMac        string   `json:"uplink_mac,omitempty"`
+	UplinkRemotePort FlexInt  `json:"uplink_remote_port"`
 	Uptime           FlexInt  `json:"uptime"`
 	XputDown         FlexInt  `json:"xput_down,omitempty"`
 	XputUp           FlexInt  `json:"xput_up,omitempty"`
@@ -103,6 +103,7 @@ type USW struct {
 	Unsupported             FlexBool    `json:"unsupported"`
 	UnsupportedReason       FlexInt     `json:"unsupported_reason"`
 	Upgradable              FlexBool    `json:"upgradable,omitempty"`
+	UpgradeToFirmware       string      `json:"upgrade_to_firmware"`
 	Upgradeable             FlexBool    `json:"upgradeable"`
 	Uplink                  Uplink      `json:"uplink"`
 	UplinkDepth             FlexInt     `json:"uplink_depth"`
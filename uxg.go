@@ -113,6 +113,8 @@ type UXG struct {
 	Unsupported                FlexBool                `json:"unsupported"`
 	UnsupportedReason          FlexInt                 `json:"unsupported_reason"`
 	UpgradeState               FlexInt                 `json:"upgrade_state"`
+	Upgradable                 FlexBool                `json:"upgradable"`
+	UpgradeToFirmware          string                  `json:"upgrade_to_firmware"`
 	Uplink                     Uplink                  `json:"uplink"`
 	Uptime                     FlexInt                 `json:"uptime"`
 	UptimeStats                map[string]*UptimeStats `json:"uptime_stats"`
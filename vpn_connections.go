@@ -0,0 +1,245 @@
+package unifi
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// VPN connection states reported in VPNConnection.Status.
+const (
+	VPNConnectionConnected    = "CONNECTED"
+	VPNConnectionDisconnected = "DISCONNECTED"
+)
+
+// GetVPNConnections returns live VPN connection state for a single site.
+// Includes remote-access clients and site-to-site peers.
+// Uses the v2 API endpoint: GET /proxy/network/v2/api/site/{site}/vpn/connections
+func (u *Unifi) GetVPNConnections(site *Site) ([]*VPNConnection, error) {
+	if site == nil || site.Name == "" {
+		return nil, ErrNoSiteProvided
+	}
+
+	u.DebugLog("Polling Controller for VPN connections, site %s", site.SiteName)
+
+	body, err := u.GetJSON(fmt.Sprintf(APIVPNConnectionsPath, site.Name))
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch VPN connections for site %s: %w", site.SiteName, err)
+	}
+
+	var raw []*VPNConnection
+	if err := json.Unmarshal(body, &raw); err != nil {
+		return nil, fmt.Errorf("failed to parse VPN connections for site %s: %w", site.SiteName, err)
+	}
+
+	connections := make([]*VPNConnection, 0, len(raw))
+
+	for _, c := range raw {
+		if c == nil {
+			continue
+		}
+
+		c.SiteName = site.SiteName
+		c.SourceName = u.URL
+		connections = append(connections, c)
+	}
+
+	return connections, nil
+}
+
+// GetWireGuardUsers returns the configured WireGuard peers (clients) for a single site.
+// Uses the v2 API endpoint: GET /proxy/network/v2/api/site/{site}/wireguard/users
+func (u *Unifi) GetWireGuardUsers(site *Site) ([]*WireGuardUser, error) {
+	if site == nil || site.Name == "" {
+		return nil, ErrNoSiteProvided
+	}
+
+	u.DebugLog("Polling Controller for WireGuard users, site %s", site.SiteName)
+
+	body, err := u.GetJSON(fmt.Sprintf(APIWireGuardUsersPath, site.Name))
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch WireGuard users for site %s: %w", site.SiteName, err)
+	}
+
+	var raw []*WireGuardUser
+	if err := json.Unmarshal(body, &raw); err != nil {
+		return nil, fmt.Errorf("failed to parse WireGuard users for site %s: %w", site.SiteName, err)
+	}
+
+	users := make([]*WireGuardUser, 0, len(raw))
+
+	for _, w := range raw {
+		if w == nil {
+			continue
+		}
+
+		w.SiteName = site.SiteName
+		w.SourceName = u.URL
+		users = append(users, w)
+	}
+
+	return users, nil
+}
+
+// VPNConnection represents the live state of a single VPN peer or tunnel.
+// NetworkID is the legacy _id of the VPN network (Network.ID); see JoinVPNConnections
+// for matching connections to Integration/v1 servers and tunnels.
+// PeerID references WireGuardUser.ID for WireGuard remote-access clients.
+type VPNConnection struct {
+	ID              string  `fake:"{uuid}"                                   json:"id"`
+	LatestHandshake FlexInt `json:"latest_handshake"` // Unix timestamp in seconds, 0 when never seen
+	LocalIP         string  `fake:"{ipv4address}"                            json:"local_ip"`
+	Name            string  `fake:"{buzzword}"                               json:"name"`
+	NetworkID       string  `fake:"{uuid}"                                   json:"network_id"`
+	PeerID          string  `fake:"{uuid}"                                   json:"peer_id,omitempty"`
+	PeerName        string  `fake:"{username}"                               json:"peer_name"`
+	RemoteIP        string  `fake:"{ipv4address}"                            json:"remote_ip"`
+	RxBytes         FlexInt `json:"rx_bytes"`
+	Status          string  `fake:"{randomstring:[CONNECTED,DISCONNECTED]}"  json:"status"`
+	TunnelIP        string  `fake:"{ipv4address}"                            json:"tunnel_ip,omitempty"`
+	TxBytes         FlexInt `json:"tx_bytes"`
+	Type            string  `fake:"{randomstring:[WireGuard,OpenVPN,IPSec]}" json:"type"`
+	Uptime          FlexInt `json:"uptime"`
+
+	SiteName   string `json:"-"`
+	SourceName string `json:"-"`
+}
+
+// WireGuardUser represents a configured WireGuard peer on a WireGuard VPN server.
+// NetworkID is the legacy _id of the WireGuard server network (Network.ID).
+type WireGuardUser struct {
+	ID            string   `fake:"{uuid}"        json:"_id"`
+	AllowedIPs    []string `fakesize:"1"         json:"allowed_ips"`
+	InterfaceIP   string   `fake:"{ipv4address}" json:"interface_ip"`
+	InterfaceIPv6 string   `json:"interface_ipv6,omitempty"`
+	Name          string   `fake:"{username}"    json:"name"`
+	NetworkID     string   `fake:"{uuid}"        json:"network_id"`
+	PresharedKey  string   `json:"preshared_key,omitempty"`
+	PublicKey     string   `fake:"{uuid}"        json:"public_key"`
+	SiteID        string   `fake:"{uuid}"        json:"site_id"`
+
+	SiteName   string `json:"-"`
+	SourceName string `json:"-"`
+}
+
+// Connected reports whether the controller considers the connection up.
+func (c *VPNConnection) Connected() bool {
+	return c.Status == VPNConnectionConnected
+}
+
+// HandshakeAge returns how long ago the latest handshake happened, relative to now.
+// Returns -1 when no handshake has been recorded.
+func (c *VPNConnection) HandshakeAge(now time.Time) time.Duration {
+	if c.LatestHandshake.Int64() <= 0 {
+		return -1
+	}
+
+	return now.Sub(time.Unix(c.LatestHandshake.Int64(), 0))
+}
+
+// Stale reports whether the latest handshake is older than maxAge, or missing entirely.
+// WireGuard re-keys every two minutes, so a few minutes without a handshake means the peer is gone.
+func (c *VPNConnection) Stale(now time.Time, maxAge time.Duration) bool {
+	age := c.HandshakeAge(now)
+
+	return age < 0 || age > maxAge
+}
+
+// JoinVPNConnections attaches live connection state to configured VPN servers and
+// site-to-site tunnels. VPNConnection.NetworkID is a legacy network _id while
+// VPNServer.ID and SiteToSiteTunnel.ID are Integration/v1 UUIDs, so the two never
+// match directly. Instead NetworkID is resolved to its Network (from GetNetworks)
+// and joined by name and VPN type; without a matching network the connection's
+// own Name is used. Names compare case-insensitively, and types only when both
+// sides report one. Servers collect every matching connection; tunnels keep the first.
+func JoinVPNConnections(servers []*VPNServer, tunnels []*SiteToSiteTunnel, connections []*VPNConnection, networks []Network) {
+	byName := make(map[string][]*VPNConnection)
+
+	for _, c := range connections {
+		if c == nil {
+			continue
+		}
+
+		name := c.Name
+		if n := findNetwork(networks, c.NetworkID); n != nil && n.Name != "" {
+			name = n.Name
+		}
+
+		key := strings.ToLower(strings.TrimSpace(name))
+		byName[key] = append(byName[key], c)
+	}
+
+	matching := func(name, vpnType string) []*VPNConnection {
+		result := make([]*VPNConnection, 0)
+
+		for _, c := range byName[strings.ToLower(strings.TrimSpace(name))] {
+			connType := c.Type
+			if n := findNetwork(networks, c.NetworkID); n != nil && connType == "" {
+				connType = n.VPNType
+			}
+
+			if a, b := vpnTypeFamily(vpnType), vpnTypeFamily(connType); a == "" || b == "" || a == b {
+				result = append(result, c)
+			}
+		}
+
+		return result
+	}
+
+	for _, s := range servers {
+		if s == nil {
+			continue
+		}
+
+		s.Connections = nil
+
+		if conns := matching(s.Name, s.Type); len(conns) > 0 {
+			s.Connections = conns
+		}
+	}
+
+	for _, t := range tunnels {
+		if t == nil {
+			continue
+		}
+
+		t.Connection = nil
+
+		if conns := matching(t.Name, t.Type); len(conns) > 0 {
+			t.Connection = conns[0]
+		}
+	}
+}
+
+// vpnTypeFamily reduces the VPN type spellings of the different APIs, e.g.
+// "WireGuard" and "wireguard-server", to one protocol name. Unknown types return "".
+func vpnTypeFamily(vpnType string) string {
+	vpnType = strings.ToLower(vpnType)
+
+	for _, family := range []string{"wireguard", "openvpn", "ipsec", "l2tp"} {
+		if strings.Contains(vpnType, family) {
+			return family
+		}
+	}
+
+	return ""
+}
+
+// StaleConnections returns the server's joined connections whose handshake is older than maxAge.
+func (s *VPNServer) StaleConnections(now time.Time, maxAge time.Duration) []*VPNConnection {
+	stale := make([]*VPNConnection, 0)
+
+	for _, c := range s.Connections {
+		if c.Stale(now, maxAge) {
+			stale = append(stale, c)
+		}
+	}
+
+	return stale
+}
+
+// Stale reports whether the tunnel has no joined connection or its handshake is older than maxAge.
+func (t *SiteToSiteTunnel) Stale(now time.Time, maxAge time.Duration) bool {
+	return t.Connection == nil || t.Connection.Stale(now, maxAge)
+}
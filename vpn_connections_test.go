@@ -0,0 +1,81 @@
+package unifi_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/stretchr/testify/require"
+	"github.com/unpoller/unifi/v5"
+)
+
+func TestVPNConnectionStruct(t *testing.T) {
+	t.Parallel()
+
+	var c unifi.VPNConnection
+
+	err := gofakeit.Struct(&c)
+	require.NoError(t, err)
+	require.NotEmpty(t, c.ID)
+	require.NotEmpty(t, c.NetworkID)
+}
+
+func TestWireGuardUserStruct(t *testing.T) {
+	t.Parallel()
+
+	var w unifi.WireGuardUser
+
+	err := gofakeit.Struct(&w)
+	require.NoError(t, err)
+	require.NotEmpty(t, w.ID)
+	require.NotEmpty(t, w.PublicKey)
+}
+
+func TestJoinVPNConnections(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	fresh := *unifi.NewFlexInt(float64(now.Add(-time.Minute).Unix()))
+	old := *unifi.NewFlexInt(float64(now.Add(-time.Hour).Unix()))
+
+	// Integration/v1 IDs are UUIDs; the v2 connection API uses legacy Mongo _ids.
+	servers := []*unifi.VPNServer{
+		{ID: "0d6b3a52-8c7e-4f0e-9a61-3b2c5d7e9f10", Name: "Road Warriors", Type: "WireGuard"},
+		{ID: "5e1f2a3b-4c5d-4e6f-8a7b-9c0d1e2f3a4b", Name: "Legacy", Type: "OpenVPN"},
+		nil,
+	}
+	tunnels := []*unifi.SiteToSiteTunnel{
+		{ID: "a1b2c3d4-e5f6-4a7b-8c9d-0e1f2a3b4c5d", Name: "Branch Office", Type: "IPSec"},
+		{ID: "b2c3d4e5-f6a7-4b8c-9d0e-1f2a3b4c5d6e", Name: "Warehouse", Type: "WireGuard"},
+		nil,
+	}
+	networks := []unifi.Network{
+		{ID: "65f1c2a9e4b0c12d3456789a", Name: "Road Warriors", VPNType: "wireguard-server"},
+		{ID: "65f1c2a9e4b0c12d3456789b", Name: "Branch Office", VPNType: "ipsec-vpn"},
+		{ID: "65f1c2a9e4b0c12d3456789c", Name: "Legacy", VPNType: "openvpn-server"},
+	}
+	connections := []*unifi.VPNConnection{
+		{ID: "a", NetworkID: "65f1c2a9e4b0c12d3456789a", Type: "WireGuard", LatestHandshake: fresh},
+		{ID: "b", NetworkID: "65f1c2a9e4b0c12d3456789a", Type: "WireGuard", LatestHandshake: old},
+		{ID: "c", NetworkID: "65f1c2a9e4b0c12d3456789a"},
+		{ID: "d", NetworkID: "65f1c2a9e4b0c12d3456789b", LatestHandshake: fresh},
+		// Same name as the warehouse tunnel but a different protocol: must not join.
+		{ID: "e", NetworkID: "65f1c2a9e4b0c12d3456789f", Name: "warehouse", Type: "OpenVPN"},
+		nil,
+	}
+
+	unifi.JoinVPNConnections(servers, tunnels, connections, networks)
+
+	require.Len(t, servers[0].Connections, 3)
+	require.Empty(t, servers[1].Connections)
+
+	stale := servers[0].StaleConnections(now, 5*time.Minute)
+	require.Len(t, stale, 2)
+	require.Equal(t, "b", stale[0].ID)
+	require.Equal(t, time.Duration(-1), stale[1].HandshakeAge(now))
+
+	require.NotNil(t, tunnels[0].Connection)
+	require.False(t, tunnels[0].Stale(now, 5*time.Minute))
+	require.Nil(t, tunnels[1].Connection)
+	require.True(t, tunnels[1].Stale(now, 5*time.Minute))
+}
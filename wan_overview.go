@@ -0,0 +1,327 @@
+package unifi
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+// WANNetworkGroup represents per-WAN port information from /v2/api/site/{site}/wan/networkgroups.
+// WANNetworkgroup is the group name (WAN, WAN2, ...) used to join the other WAN endpoints.
+type WANNetworkGroup struct {
+	DeviceMAC       string   `fake:"{macaddress}"              json:"device_mac"`
+	Media           string   `json:"media,omitempty"` // GE, SFP+, 5G, etc.
+	Name            string   `fake:"{buzzword}"                json:"name"`
+	PortIndex       FlexInt  `json:"port_idx"`
+	PortName        string   `json:"port_name"`
+	Priority        FlexInt  `json:"priority"`
+	Speed           FlexInt  `json:"speed"` // link speed in Mbps
+	Up              FlexBool `json:"up"`
+	Uptime          FlexInt  `json:"uptime"` // seconds
+	WANNetworkgroup string   `fake:"{randomstring:[WAN,WAN2]}" json:"wan_networkgroup"`
+
+	SiteName string `json:"-"`
+}
+
+// WANMagicConfiguration represents the data usage cap settings of a WAN from /v2/api/site/{site}/wan/magic/configuration.
+type WANMagicConfiguration struct {
+	BillingCycleDay  FlexInt  `json:"billing_cycle_day"`
+	DataLimit        FlexInt  `json:"data_limit"` // bytes per billing cycle, 0 when unlimited
+	DataLimitEnabled FlexBool `json:"data_limit_enabled"`
+	Enabled          FlexBool `json:"enabled"`
+	WANNetworkgroup  string   `fake:"{randomstring:[WAN,WAN2]}" json:"wan_networkgroup"`
+
+	SiteName string `json:"-"`
+}
+
+// WANMagicSubscription represents a metered WAN subscription and its usage from /v2/api/site/{site}/wan/magic/subscription.
+type WANMagicSubscription struct {
+	DataCap         FlexInt `json:"data_cap"`  // bytes per period, 0 when unlimited
+	DataUsed        FlexInt `json:"data_used"` // bytes used this period
+	PeriodEnd       FlexInt `json:"period_end"`
+	PeriodStart     FlexInt `json:"period_start"`
+	Plan            string  `fake:"{buzzword}"                      json:"plan"`
+	Status          string  `fake:"{randomstring:[ACTIVE,EXPIRED]}" json:"status"`
+	WANNetworkgroup string  `fake:"{randomstring:[WAN,WAN2]}"       json:"wan_networkgroup"`
+
+	SiteName string `json:"-"`
+}
+
+// WANOverview merges every WAN data source for a single WAN, keyed by WANNetworkgroup.
+// Fields are nil when the corresponding endpoint had no entry for this WAN.
+type WANOverview struct {
+	WANNetworkgroup    string
+	Name               string
+	Configuration      *WANConfiguration
+	Details            *WANDetails
+	Statistics         *WANStatistics
+	LoadBalancing      *WANLoadBalancingIface
+	NetworkGroup       *WANNetworkGroup
+	MagicConfiguration *WANMagicConfiguration
+	MagicSubscription  *WANMagicSubscription
+	ISPStatus          *WANISPStatusDetailed
+	SiteName           string
+}
+
+// GetWANNetworkGroups returns per-WAN port information for a single site.
+// Uses the v2 API endpoint: GET /proxy/network/v2/api/site/{site}/wan/networkgroups
+func (u *Unifi) GetWANNetworkGroups(site *Site) ([]*WANNetworkGroup, error) {
+	if site == nil || site.Name == "" {
+		return nil, ErrNoSiteProvided
+	}
+
+	u.DebugLog("Polling Controller for WAN network groups, site %s", site.SiteName)
+
+	body, err := u.GetJSON(fmt.Sprintf(APIWANNetworkGroupsPath, site.Name))
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch WAN network groups for site %s: %w", site.SiteName, err)
+	}
+
+	var raw []*WANNetworkGroup
+	if err := json.Unmarshal(body, &raw); err != nil {
+		return nil, fmt.Errorf("failed to parse WAN network groups for site %s: %w", site.SiteName, err)
+	}
+
+	groups := make([]*WANNetworkGroup, 0, len(raw))
+
+	for _, g := range raw {
+		if g == nil {
+			continue
+		}
+
+		g.SiteName = site.SiteName
+		groups = append(groups, g)
+	}
+
+	return groups, nil
+}
+
+// GetWANMagicConfiguration returns data usage cap settings for each WAN on a single site.
+// Uses the v2 API endpoint: GET /proxy/network/v2/api/site/{site}/wan/magic/configuration
+func (u *Unifi) GetWANMagicConfiguration(site *Site) ([]*WANMagicConfiguration, error) {
+	if site == nil || site.Name == "" {
+		return nil, ErrNoSiteProvided
+	}
+
+	u.DebugLog("Polling Controller for WAN magic configuration, site %s", site.SiteName)
+
+	body, err := u.GetJSON(fmt.Sprintf(APIWANMagicConfigurationPath, site.Name))
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch WAN magic configuration for site %s: %w", site.SiteName, err)
+	}
+
+	var raw []*WANMagicConfiguration
+	if err := json.Unmarshal(body, &raw); err != nil {
+		return nil, fmt.Errorf("failed to parse WAN magic configuration for site %s: %w", site.SiteName, err)
+	}
+
+	configs := make([]*WANMagicConfiguration, 0, len(raw))
+
+	for _, c := range raw {
+		if c == nil {
+			continue
+		}
+
+		c.SiteName = site.SiteName
+		configs = append(configs, c)
+	}
+
+	return configs, nil
+}
+
+// GetWANMagicSubscription returns metered WAN subscriptions and their usage for a single site.
+// Uses the v2 API endpoint: GET /proxy/network/v2/api/site/{site}/wan/magic/subscription
+func (u *Unifi) GetWANMagicSubscription(site *Site) ([]*WANMagicSubscription, error) {
+	if site == nil || site.Name == "" {
+		return nil, ErrNoSiteProvided
+	}
+
+	u.DebugLog("Polling Controller for WAN magic subscription, site %s", site.SiteName)
+
+	body, err := u.GetJSON(fmt.Sprintf(APIWANMagicSubscriptionPath, site.Name))
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch WAN magic subscription for site %s: %w", site.SiteName, err)
+	}
+
+	var raw []*WANMagicSubscription
+	if err := json.Unmarshal(body, &raw); err != nil {
+		return nil, fmt.Errorf("failed to parse WAN magic subscription for site %s: %w", site.SiteName, err)
+	}
+
+	subs := make([]*WANMagicSubscription, 0, len(raw))
+
+	for _, s := range raw {
+		if s == nil {
+			continue
+		}
+
+		s.SiteName = site.SiteName
+		subs = append(subs, s)
+	}
+
+	return subs, nil
+}
+
+// UsagePercentage returns DataUsed as a percentage of DataCap, or 0 when there is no cap.
+func (s *WANMagicSubscription) UsagePercentage() float64 {
+	if s.DataCap.Val <= 0 {
+		return 0
+	}
+
+	return s.DataUsed.Val / s.DataCap.Val * 100
+}
+
+// GetWANOverview fetches the enriched configuration, load balancing status, network groups,
+// Magic WAN configuration and subscription and the ISP status of each WAN for a site,
+// and merges them into one record per WAN. The Magic WAN and ISP status endpoints are
+// missing on some controllers; ErrEndpointNotFound from them leaves the related fields
+// nil, and any other error is returned.
+func (u *Unifi) GetWANOverview(site *Site) ([]*WANOverview, error) {
+	if site == nil || site.Name == "" {
+		return nil, ErrNoSiteProvided
+	}
+
+	configs, err := u.GetWANEnrichedConfiguration([]*Site{site})
+	if err != nil {
+		return nil, fmt.Errorf("fetching WAN enriched configuration for site %s: %w", site.SiteName, err)
+	}
+
+	balance, err := u.GetWANLoadBalancingStatus([]*Site{site})
+	if err != nil {
+		return nil, fmt.Errorf("fetching WAN load balancing status for site %s: %w", site.SiteName, err)
+	}
+
+	groups, err := u.GetWANNetworkGroups(site)
+	if err != nil {
+		return nil, err
+	}
+
+	magic, err := u.GetWANMagicConfiguration(site)
+	if err = optionalWANSource(u, site, "magic configuration", err); err != nil {
+		return nil, err
+	}
+
+	subs, err := u.GetWANMagicSubscription(site)
+	if err = optionalWANSource(u, site, "magic subscription", err); err != nil {
+		return nil, err
+	}
+
+	overview := MergeWANOverview(configs, balance, groups, magic, subs, nil)
+
+	for _, o := range overview {
+		o.SiteName = site.SiteName
+
+		if o.WANNetworkgroup == "" {
+			continue
+		}
+
+		status, err := u.GetWANISPStatus([]*Site{site}, o.WANNetworkgroup)
+		if err = optionalWANSource(u, site, o.WANNetworkgroup+" ISP status", err); err != nil {
+			return nil, err
+		}
+
+		o.ISPStatus = status
+	}
+
+	return overview, nil
+}
+
+// optionalWANSource returns nil for a WAN endpoint the controller does not serve,
+// and wraps any other error.
+func optionalWANSource(u *Unifi, site *Site, name string, err error) error {
+	if errors.Is(err, ErrEndpointNotFound) {
+		u.DebugLog("Skipping WAN %s for site %s: %v", name, site.SiteName, err)
+
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("fetching WAN %s for site %s: %w", name, site.SiteName, err)
+	}
+
+	return nil
+}
+
+// MergeWANOverview joins WAN data from the individual endpoints by WAN network group name.
+// ISP status is keyed by WAN network group name and only attached to WANs found in the
+// other sources. WANs are returned in enriched-configuration order, followed by any WAN
+// that only appears in the other sources. Any argument may be nil.
+func MergeWANOverview(
+	configs []*WANEnrichedConfiguration,
+	balance *WANLoadBalancingStatus,
+	groups []*WANNetworkGroup,
+	magic []*WANMagicConfiguration,
+	subs []*WANMagicSubscription,
+	isp map[string]*WANISPStatusDetailed,
+) []*WANOverview {
+	overview := make([]*WANOverview, 0, len(configs))
+	byGroup := make(map[string]*WANOverview)
+	get := func(group string) *WANOverview {
+		if o, ok := byGroup[group]; ok {
+			return o
+		}
+
+		o := &WANOverview{WANNetworkgroup: group}
+		byGroup[group] = o
+		overview = append(overview, o)
+
+		return o
+	}
+
+	for _, c := range configs {
+		if c == nil {
+			continue
+		}
+
+		o := get(c.Configuration.WANNetworkgroup)
+		o.Name = c.Configuration.Name
+		o.Configuration = &c.Configuration
+		o.Details = &c.Details
+		o.Statistics = &c.Statistics
+	}
+
+	if balance != nil {
+		for i := range balance.WANInterfaces {
+			iface := &balance.WANInterfaces[i]
+			o := get(iface.WANNetworkgroup)
+			o.LoadBalancing = iface
+
+			if o.Name == "" {
+				o.Name = iface.Name
+			}
+		}
+	}
+
+	for _, g := range groups {
+		if g == nil {
+			continue
+		}
+
+		o := get(g.WANNetworkgroup)
+		o.NetworkGroup = g
+
+		if o.Name == "" {
+			o.Name = g.Name
+		}
+	}
+
+	for _, m := range magic {
+		if m != nil {
+			get(m.WANNetworkgroup).MagicConfiguration = m
+		}
+	}
+
+	for _, s := range subs {
+		if s != nil {
+			get(s.WANNetworkgroup).MagicSubscription = s
+		}
+	}
+
+	for group, status := range isp {
+		if o, ok := byGroup[group]; ok && status != nil {
+			o.ISPStatus = status
+		}
+	}
+
+	return overview
+}
@@ -0,0 +1,120 @@
+package unifi_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/stretchr/testify/require"
+	"github.com/unpoller/unifi/v5"
+)
+
+func TestWANNetworkGroupStruct(t *testing.T) {
+	t.Parallel()
+
+	var g unifi.WANNetworkGroup
+
+	err := gofakeit.Struct(&g)
+	require.NoError(t, err)
+	require.NotEmpty(t, g.WANNetworkgroup)
+}
+
+func TestWANMagicSubscriptionUsage(t *testing.T) {
+	t.Parallel()
+
+	var s unifi.WANMagicSubscription
+
+	err := gofakeit.Struct(&s)
+	require.NoError(t, err)
+
+	s.DataCap = *unifi.NewFlexInt(200)
+	s.DataUsed = *unifi.NewFlexInt(50)
+	require.InDelta(t, 25.0, s.UsagePercentage(), 0.001)
+
+	s.DataCap = *unifi.NewFlexInt(0)
+	require.Zero(t, s.UsagePercentage())
+}
+
+func TestMergeWANOverview(t *testing.T) {
+	t.Parallel()
+
+	configs := []*unifi.WANEnrichedConfiguration{
+		{Configuration: unifi.WANConfiguration{ID: "wan1", Name: "Fiber", WANNetworkgroup: "WAN"}},
+		nil,
+	}
+	balance := &unifi.WANLoadBalancingStatus{WANInterfaces: []unifi.WANLoadBalancingIface{
+		{Name: "Fiber", WANNetworkgroup: "WAN", Priority: *unifi.NewFlexInt(1)},
+		{Name: "LTE", WANNetworkgroup: "WAN2", Priority: *unifi.NewFlexInt(2)},
+	}}
+	groups := []*unifi.WANNetworkGroup{{WANNetworkgroup: "WAN", PortName: "Port 9"}, nil}
+	subs := []*unifi.WANMagicSubscription{{WANNetworkgroup: "WAN2", Plan: "10GB"}}
+
+	isp := map[string]*unifi.WANISPStatusDetailed{"WAN": {PingServer: "1.1.1.1"}, "WAN9": {}}
+
+	overview := unifi.MergeWANOverview(configs, balance, groups, nil, subs, isp)
+	require.Len(t, overview, 2)
+
+	require.Equal(t, "WAN", overview[0].WANNetworkgroup)
+	require.Equal(t, "Fiber", overview[0].Name)
+	require.Equal(t, "wan1", overview[0].Configuration.ID)
+	require.Equal(t, "Port 9", overview[0].NetworkGroup.PortName)
+	require.EqualValues(t, 1, overview[0].LoadBalancing.Priority.Val)
+	require.Nil(t, overview[0].MagicSubscription)
+	require.Equal(t, "1.1.1.1", overview[0].ISPStatus.PingServer)
+
+	require.Equal(t, "WAN2", overview[1].WANNetworkgroup)
+	require.Equal(t, "LTE", overview[1].Name)
+	require.Nil(t, overview[1].Configuration)
+	require.Equal(t, "10GB", overview[1].MagicSubscription.Plan)
+	require.Nil(t, overview[1].ISPStatus)
+}
+
+func TestGetWANOverview(t *testing.T) {
+	t.Parallel()
+
+	var magicStatus atomic.Int32
+
+	magicStatus.Store(http.StatusNotFound)
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/proxy/network/v2/api/site/default/wan/enriched-configuration":
+			_, _ = w.Write([]byte(`[{"configuration":{"_id":"wan1","name":"Fiber","wan_networkgroup":"WAN"}}]`))
+		case "/proxy/network/v2/api/site/default/wan/load-balancing/status":
+			_, _ = w.Write([]byte(`{"wan_interfaces":[{"name":"LTE","wan_networkgroup":"WAN2"}]}`))
+		case "/proxy/network/v2/api/site/default/wan/networkgroups":
+			_, _ = w.Write([]byte(`[]`))
+		case "/proxy/network/v2/api/site/default/wan/WAN/isp-status":
+			_, _ = w.Write([]byte(`{"ping_server":"1.1.1.1"}`))
+		case "/proxy/network/v2/api/site/default/wan/magic/configuration",
+			"/proxy/network/v2/api/site/default/wan/magic/subscription":
+			w.WriteHeader(int(magicStatus.Load()))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer srv.Close()
+
+	discard := func(string, ...any) {}
+	u := &unifi.Unifi{
+		Client: &http.Client{},
+		Config: &unifi.Config{URL: srv.URL, DebugLog: discard, ErrorLog: discard},
+	}
+
+	site := &unifi.Site{Name: "default", SiteName: "Default"}
+
+	overview, err := u.GetWANOverview(site)
+	require.NoError(t, err, "missing endpoints are skipped")
+	require.Len(t, overview, 2)
+	require.Equal(t, "1.1.1.1", overview[0].ISPStatus.PingServer)
+	require.Nil(t, overview[0].MagicConfiguration)
+	require.Nil(t, overview[1].ISPStatus)
+	require.Equal(t, "Default", overview[1].SiteName)
+
+	magicStatus.Store(http.StatusInternalServerError)
+
+	_, err = u.GetWANOverview(site)
+	require.ErrorIs(t, err, unifi.ErrInvalidStatusCode, "other errors are returned")
+}
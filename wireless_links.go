@@ -0,0 +1,153 @@
+package unifi
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// Topology edge types reported in TopologyEdge.Type.
+const (
+	TopologyEdgeWired    = "WIRED"
+	TopologyEdgeWireless = "WIRELESS"
+)
+
+// APGroup represents an access point group from /v2/api/site/{site}/apgroups.
+// WLANs are broadcast on the APs of the groups they are scoped to.
+type APGroup struct {
+	ID           string   `fake:"{uuid}"     json:"_id"`
+	AttrHiddenID string   `json:"attr_hidden_id,omitempty"`
+	AttrNoDelete FlexBool `json:"attr_no_delete"`
+	DeviceMACs   []string `fakesize:"2"      json:"device_macs"`
+	Name         string   `fake:"{buzzword}" json:"name"`
+
+	SiteName   string `json:"-"`
+	SourceName string `json:"-"`
+}
+
+// WirelessLink represents a mesh or point-to-point wireless uplink from
+// /v2/api/site/{site}/device/wireless-links. UplinkMac is the parent device and
+// DownlinkMac the device that uplinks over the air. Rates are in Kbps.
+type WirelessLink struct {
+	Channel     FlexInt `json:"channel"`
+	DownlinkMac string  `fake:"{macaddress}"             json:"downlink_mac"`
+	Essid       string  `json:"essid,omitempty"`
+	RadioBand   string  `json:"radio_band"` // ng, na, 6e, or ad/60g for UBB links
+	RxRate      FlexInt `json:"rx_rate"`
+	Signal      FlexInt `json:"signal"` // dBm
+	TxRate      FlexInt `json:"tx_rate"`
+	Type        string  `fake:"{randomstring:[MESH,P2P]}" json:"type"`
+	UplinkMac   string  `fake:"{macaddress}"             json:"uplink_mac"`
+
+	SiteName   string `json:"-"`
+	SourceName string `json:"-"`
+}
+
+// GetAPGroups returns access point groups for a single site.
+// Uses the v2 API endpoint: GET /proxy/network/v2/api/site/{site}/apgroups
+func (u *Unifi) GetAPGroups(site *Site) ([]*APGroup, error) {
+	if site == nil || site.Name == "" {
+		return nil, ErrNoSiteProvided
+	}
+
+	u.DebugLog("Polling Controller for AP groups, site %s", site.SiteName)
+
+	body, err := u.GetJSON(fmt.Sprintf(APIAPGroupsPath, site.Name))
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch AP groups for site %s: %w", site.SiteName, err)
+	}
+
+	var raw []*APGroup
+	if err := json.Unmarshal(body, &raw); err != nil {
+		return nil, fmt.Errorf("failed to parse AP groups for site %s: %w", site.SiteName, err)
+	}
+
+	groups := make([]*APGroup, 0, len(raw))
+
+	for _, g := range raw {
+		if g == nil {
+			continue
+		}
+
+		g.SiteName = site.SiteName
+		g.SourceName = u.URL
+		groups = append(groups, g)
+	}
+
+	return groups, nil
+}
+
+// GetWirelessLinks returns mesh and point-to-point wireless uplinks for a single site.
+// Uses the v2 API endpoint: GET /proxy/network/v2/api/site/{site}/device/wireless-links
+func (u *Unifi) GetWirelessLinks(site *Site) ([]*WirelessLink, error) {
+	if site == nil || site.Name == "" {
+		return nil, ErrNoSiteProvided
+	}
+
+	u.DebugLog("Polling Controller for wireless links, site %s", site.SiteName)
+
+	body, err := u.GetJSON(fmt.Sprintf(APIWirelessLinksPath, site.Name))
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch wireless links for site %s: %w", site.SiteName, err)
+	}
+
+	var raw []*WirelessLink
+	if err := json.Unmarshal(body, &raw); err != nil {
+		return nil, fmt.Errorf("failed to parse wireless links for site %s: %w", site.SiteName, err)
+	}
+
+	links := make([]*WirelessLink, 0, len(raw))
+
+	for _, l := range raw {
+		if l == nil {
+			continue
+		}
+
+		l.SiteName = site.SiteName
+		l.SourceName = u.URL
+		links = append(links, l)
+	}
+
+	return links, nil
+}
+
+// MergeWirelessLinks adds the wireless links to the topology as WIRELESS edges.
+// An existing edge between the same two devices, in either direction, is converted
+// to WIRELESS and updated with the link's signal, rate, channel and band; otherwise
+// a new edge is appended. Links without both MACs are skipped.
+func (t *Topology) MergeWirelessLinks(links []*WirelessLink) {
+	for _, l := range links {
+		if l == nil || l.UplinkMac == "" || l.DownlinkMac == "" {
+			continue
+		}
+
+		edge := t.findEdge(l.UplinkMac, l.DownlinkMac)
+		if edge == nil {
+			t.Edges = append(t.Edges, TopologyEdge{UplinkMac: l.UplinkMac, DownlinkMac: l.DownlinkMac})
+			edge = &t.Edges[len(t.Edges)-1]
+		}
+
+		edge.Type = TopologyEdgeWireless
+		edge.Signal = l.Signal
+		edge.RateMbps = *NewFlexInt(l.TxRate.Val / 1000) // Kbps to Mbps
+		edge.Channel = l.Channel
+		edge.RadioBand = l.RadioBand
+
+		if l.Essid != "" {
+			edge.Essid = l.Essid
+		}
+	}
+}
+
+// findEdge returns the edge between two devices in either direction, or nil.
+func (t *Topology) findEdge(macA, macB string) *TopologyEdge {
+	a, b := normalizeMAC(macA), normalizeMAC(macB)
+
+	for i := range t.Edges {
+		up, down := normalizeMAC(t.Edges[i].UplinkMac), normalizeMAC(t.Edges[i].DownlinkMac)
+		if (up == a && down == b) || (up == b && down == a) {
+			return &t.Edges[i]
+		}
+	}
+
+	return nil
+}
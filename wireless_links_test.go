@@ -0,0 +1,57 @@
+package unifi_test
+
+import (
+	"testing"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/stretchr/testify/require"
+	"github.com/unpoller/unifi/v5"
+)
+
+func TestAPGroupStruct(t *testing.T) {
+	t.Parallel()
+
+	var g unifi.APGroup
+
+	err := gofakeit.Struct(&g)
+	require.NoError(t, err)
+	require.NotEmpty(t, g.ID)
+	require.NotEmpty(t, g.DeviceMACs)
+}
+
+func TestWirelessLinkStruct(t *testing.T) {
+	t.Parallel()
+
+	var l unifi.WirelessLink
+
+	err := gofakeit.Struct(&l)
+	require.NoError(t, err)
+	require.NotEmpty(t, l.UplinkMac)
+	require.NotEmpty(t, l.DownlinkMac)
+}
+
+func TestTopologyMergeWirelessLinks(t *testing.T) {
+	t.Parallel()
+
+	topo := &unifi.Topology{Edges: []unifi.TopologyEdge{
+		{UplinkMac: "aa:aa:aa:aa:aa:01", DownlinkMac: "aa:aa:aa:aa:aa:02", Type: unifi.TopologyEdgeWired},
+		{UplinkMac: "aa:aa:aa:aa:aa:01", DownlinkMac: "aa:aa:aa:aa:aa:03", Type: unifi.TopologyEdgeWired},
+	}}
+
+	topo.MergeWirelessLinks([]*unifi.WirelessLink{
+		// Existing edge, reported in the opposite direction with different MAC formatting.
+		{UplinkMac: "AA-AA-AA-AA-AA-03", DownlinkMac: "aa:aa:aa:aa:aa:01", Signal: *unifi.NewFlexInt(-61), TxRate: *unifi.NewFlexInt(866000)},
+		{UplinkMac: "aa:aa:aa:aa:aa:02", DownlinkMac: "aa:aa:aa:aa:aa:04", Signal: *unifi.NewFlexInt(-70), TxRate: *unifi.NewFlexInt(300000), RadioBand: "na"},
+		{UplinkMac: "aa:aa:aa:aa:aa:02"},
+		nil,
+	})
+
+	require.Len(t, topo.Edges, 3)
+	require.Equal(t, unifi.TopologyEdgeWired, topo.Edges[0].Type)
+	require.Equal(t, unifi.TopologyEdgeWireless, topo.Edges[1].Type)
+	require.EqualValues(t, -61, topo.Edges[1].Signal.Val)
+	require.EqualValues(t, 866, topo.Edges[1].RateMbps.Val)
+	require.Equal(t, unifi.TopologyEdgeWireless, topo.Edges[2].Type)
+	require.Equal(t, "aa:aa:aa:aa:aa:04", topo.Edges[2].DownlinkMac)
+	require.Equal(t, "na", topo.Edges[2].RadioBand)
+}